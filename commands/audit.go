@@ -0,0 +1,166 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// AuditFilter AuditLog.Query filter, zero fields are not checked
+type AuditFilter struct {
+	Imei      string
+	Requester string
+	From      time.Time // QueuedAt >= From
+	To        time.Time // QueuedAt < To
+	Limit     int       // max number of the most recent records
+}
+
+// AuditLog append-only command history stored in a JSON Lines file
+type AuditLog struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	nextId uint64
+}
+
+// OpenAuditLog opens (or creates) the audit log file
+func OpenAuditLog(path string) (*AuditLog, error) {
+	res := &AuditLog{path: path, nextId: 1}
+	err := res.scan(func(record *CommandRecord) {
+		if record.Id >= res.nextId {
+			res.nextId = record.Id + 1
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.file, err = os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644); err != nil {
+		return nil, fmt.Errorf("audit log open error (%v)", err)
+	}
+	// terminate a torn last line, so the next record starts on a new line
+	if info, err := res.file.Stat(); err == nil && info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err = res.file.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+			_, err = res.file.Write([]byte{'\n'})
+		}
+		if err != nil {
+			_ = res.file.Close()
+			return nil, fmt.Errorf("audit log open error (%v)", err)
+		}
+	}
+	return res, nil
+}
+
+// Record assigns the record id and appends it to the log, can be used as DispatcherConfig.OnComplete
+// with errors reported separately
+func (r *AuditLog) Record(record *CommandRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record.Id = r.nextId
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err = r.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("audit log write error (%v)", err)
+	}
+	if err = r.file.Sync(); err != nil {
+		return fmt.Errorf("audit log sync error (%v)", err)
+	}
+	r.nextId++
+	return nil
+}
+
+// Query returns records matching the filter in order of recording
+func (r *AuditLog) Query(filter AuditFilter) ([]*CommandRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]*CommandRecord, 0)
+	err := r.scan(func(record *CommandRecord) {
+		if filter.Imei != "" && record.Imei != filter.Imei ||
+			filter.Requester != "" && record.Requester != filter.Requester ||
+			!filter.From.IsZero() && record.QueuedAt.Before(filter.From) ||
+			!filter.To.IsZero() && !record.QueuedAt.Before(filter.To) {
+			return
+		}
+		res = append(res, record)
+		if filter.Limit > 0 && len(res) > filter.Limit {
+			res = res[1:]
+		}
+	})
+	return res, err
+}
+
+// Close closes the log file
+func (r *AuditLog) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+func (r *AuditLog) scan(fn func(record *CommandRecord)) error {
+	file, err := os.Open(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("audit log open error (%v)", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		record := &CommandRecord{}
+		// a torn write of the last line is skipped
+		if err = json.Unmarshal(scanner.Bytes(), record); err == nil {
+			fn(record)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("audit log read error (%v)", err)
+	}
+	return nil
+}
+
+// WriteAuditCsv writes records as CSV with a header
+func WriteAuditCsv(writer io.Writer, records []*CommandRecord) error {
+	w := csv.NewWriter(writer)
+	err := w.Write([]string{
+		"id", "requester", "imei", "codec", "command", "queuedAt", "sentAt", "answeredAt", "response", "error",
+	})
+	if err != nil {
+		return err
+	}
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339Nano)
+	}
+	for _, it := range records {
+		err = w.Write([]string{
+			strconv.FormatUint(it.Id, 10), it.Requester, it.Imei, strconv.Itoa(int(it.Codec)), it.Command,
+			formatTime(&it.QueuedAt), formatTime(it.SentAt), formatTime(it.AnsweredAt), it.Response, it.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
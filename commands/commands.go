@@ -0,0 +1,355 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+// ErrNotExecuted returned when the device answers with Codec 12 type 0x11 (command is not executed)
+var ErrNotExecuted = errors.New("command is not executed by the device")
+
+// Command a GPRS (Codec 12) command, sent to the device as "name arg1 arg2 ..."
+// or as raw bytes if Payload is set (e.g. serial passthrough data)
+type Command struct {
+	Name    string                   `json:"name,omitempty"`
+	Args    []string                 `json:"args,omitempty"`
+	Payload teltonika.MessagePayload `json:"payload,omitempty"`
+}
+
+// binaryPrefix prefix of the binary command text representation, the payload follows as a hex string
+const binaryPrefix = "hex:"
+
+// Spec describes a known command
+type Spec struct {
+	Name        string
+	Description string
+	// validate checks command arguments
+	validate func(args []string) error
+	// parse converts the response text to a struct, nil if the response is not parsed
+	parse func(text string) (interface{}, error)
+}
+
+// DigOut desired state of a digital output in setdigout command
+type DigOut byte
+
+const (
+	DigOutOff  DigOut = '0'
+	DigOutOn   DigOut = '1'
+	DigOutKeep DigOut = '?' // do not change the output state
+)
+
+// ParamValue configuration parameter value in setparam command
+type ParamValue struct {
+	Id    uint32 `json:"id"`
+	Value string `json:"value"`
+}
+
+var specs = map[string]*Spec{}
+
+func init() {
+	for _, it := range []*Spec{
+		{Name: "getinfo", Description: "device runtime information", validate: noArgs, parse: asInterface(ParseInfo)},
+		{Name: "getver", Description: "firmware, hardware and modem versions", validate: noArgs, parse: asInterface(ParseVersion)},
+		{Name: "getstatus", Description: "modem and network status", validate: noArgs, parse: asInterface(ParseStatus)},
+		{Name: "getgps", Description: "current GNSS data", validate: noArgs, parse: asInterface(ParseGPS)},
+		{Name: "getio", Description: "digital inputs, outputs and analog inputs state", validate: noArgs, parse: asInterface(ParseIO)},
+		{Name: "readio", Description: "value of the I/O element", validate: validateReadIO, parse: asInterface(ParseReadIO)},
+		{Name: "setdigout", Description: "set digital outputs", validate: validateSetDigOut, parse: asInterface(ParseKeyValues)},
+		{Name: "setparam", Description: "set configuration parameters", validate: validateSetParam, parse: asInterface(ParseParams)},
+		{Name: "getparam", Description: "read configuration parameters", validate: validateGetParam, parse: asInterface(ParseParams)},
+		{Name: "cpureset", Description: "reset the device", validate: noArgs},
+	} {
+		specs[it.Name] = it
+	}
+}
+
+// Lookup returns the spec of a known command
+func Lookup(name string) (*Spec, bool) {
+	spec, ok := specs[strings.ToLower(name)]
+	return spec, ok
+}
+
+// List returns specs of all known commands sorted by name
+func List() []*Spec {
+	res := make([]*Spec, 0, len(specs))
+	for _, it := range specs {
+		res = append(res, it)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// New create new Command, the command is not validated
+func New(name string, args ...string) *Command {
+	return &Command{Name: name, Args: args}
+}
+
+// Binary create new Command with the raw payload
+func Binary(payload []byte) *Command {
+	if payload == nil {
+		payload = []byte{}
+	}
+	return &Command{Payload: payload}
+}
+
+// GetInfo builds "getinfo" command
+func GetInfo() *Command { return New("getinfo") }
+
+// GetVer builds "getver" command
+func GetVer() *Command { return New("getver") }
+
+// GetStatus builds "getstatus" command
+func GetStatus() *Command { return New("getstatus") }
+
+// GetGPS builds "getgps" command
+func GetGPS() *Command { return New("getgps") }
+
+// GetIO builds "getio" command
+func GetIO() *Command { return New("getio") }
+
+// CPUReset builds "cpureset" command
+func CPUReset() *Command { return New("cpureset") }
+
+// ReadIO builds "readio <id>" command
+func ReadIO(id uint16) *Command {
+	return New("readio", strconv.Itoa(int(id)))
+}
+
+// SetDigOut builds "setdigout" command, outputs are DOUT1, DOUT2... states,
+// timeouts (optional) are the durations in seconds after which the outputs are switched back
+func SetDigOut(outputs []DigOut, timeouts ...uint32) (*Command, error) {
+	args := []string{string(outputs)}
+	for _, it := range timeouts {
+		args = append(args, strconv.FormatUint(uint64(it), 10))
+	}
+	cmd := New("setdigout", args...)
+	return cmd, cmd.Validate()
+}
+
+// SetParam builds "setparam id:value;id:value" command
+func SetParam(values ...ParamValue) (*Command, error) {
+	pairs := make([]string, 0, len(values))
+	for _, it := range values {
+		pairs = append(pairs, fmt.Sprintf("%d:%s", it.Id, it.Value))
+	}
+	cmd := New("setparam", strings.Join(pairs, ";"))
+	return cmd, cmd.Validate()
+}
+
+// GetParam builds "getparam id;id" command
+func GetParam(ids ...uint32) (*Command, error) {
+	list := make([]string, 0, len(ids))
+	for _, it := range ids {
+		list = append(list, strconv.FormatUint(uint64(it), 10))
+	}
+	cmd := New("getparam", strings.Join(list, ";"))
+	return cmd, cmd.Validate()
+}
+
+// ParseCommand parses and validates the command text, commands unknown to the package are only checked
+// for non-printable characters. Text with "hex:" prefix is parsed as a binary command (see Command.String)
+func ParseCommand(text string) (*Command, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("empty command")
+	}
+	if len(text) >= len(binaryPrefix) && strings.EqualFold(text[:len(binaryPrefix)], binaryPrefix) {
+		payload, err := hex.DecodeString(text[len(binaryPrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid binary command (%v)", err)
+		}
+		cmd := Binary(payload)
+		return cmd, cmd.Validate()
+	}
+	fields := strings.Fields(text)
+	cmd := New(fields[0])
+	// setparam values may contain spaces, keep its argument as is
+	if strings.EqualFold(cmd.Name, "setparam") {
+		if rest := strings.TrimSpace(text[len(fields[0]):]); rest != "" {
+			cmd.Args = []string{rest}
+		}
+	} else if len(fields) > 1 {
+		cmd.Args = fields[1:]
+	}
+	return cmd, cmd.Validate()
+}
+
+// String returns the command text as it is sent to the device,
+// binary command is returned as "hex:" followed by the payload in hex
+func (r *Command) String() string {
+	if r.Payload != nil {
+		return binaryPrefix + hex.EncodeToString(r.Payload)
+	}
+	if len(r.Args) == 0 {
+		return r.Name
+	}
+	return r.Name + " " + strings.Join(r.Args, " ")
+}
+
+// Validate checks the command syntax
+func (r *Command) Validate() error {
+	if r.Payload != nil {
+		if r.Name != "" || len(r.Args) != 0 {
+			return fmt.Errorf("binary command must not have a name or arguments")
+		}
+		if len(r.Payload) == 0 {
+			return fmt.Errorf("empty command")
+		}
+		return nil
+	}
+	if r.Name == "" {
+		return fmt.Errorf("empty command")
+	}
+	for _, c := range r.String() {
+		if c > unicode.MaxASCII || !unicode.IsPrint(c) {
+			return fmt.Errorf("command '%s': invalid character %q", r.Name, c)
+		}
+	}
+	if spec, ok := Lookup(r.Name); ok {
+		if err := spec.validate(r.Args); err != nil {
+			return fmt.Errorf("command '%s': %v", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// Packet returns Codec 12 packet with the command
+func (r *Command) Packet() *teltonika.Packet {
+	return &teltonika.Packet{
+		CodecID:  teltonika.Codec12,
+		Messages: []teltonika.Message{r.message("")},
+	}
+}
+
+// Codec14Packet returns Codec 14 packet with the command addressed to the device with the imei,
+// the device executes the command only if the imei matches, otherwise it answers with TypeNotExecuted
+func (r *Command) Codec14Packet(imei string) *teltonika.Packet {
+	return &teltonika.Packet{
+		CodecID:  teltonika.Codec14,
+		Messages: []teltonika.Message{r.message(imei)},
+	}
+}
+
+func (r *Command) message(imei string) teltonika.Message {
+	if r.Payload != nil {
+		return teltonika.Message{Type: teltonika.TypeCommand, Imei: imei, Payload: r.Payload}
+	}
+	return teltonika.Message{Type: teltonika.TypeCommand, Imei: imei, Text: r.String()}
+}
+
+// ParseResponse parses the response text of a known command,
+// returns nil without an error if the command has no parser
+func (r *Command) ParseResponse(text string) (interface{}, error) {
+	if r.Payload != nil {
+		return nil, nil
+	}
+	spec, ok := Lookup(r.Name)
+	if !ok || spec.parse == nil {
+		return nil, nil
+	}
+	return spec.parse(text)
+}
+
+// ResponseText returns the response message text, or ErrNotExecuted if the device did not execute the command
+func ResponseText(message *teltonika.Message) (string, error) {
+	switch message.Type {
+	case teltonika.TypeResponse:
+		return message.Text, nil
+	case teltonika.TypeNotExecuted:
+		return message.Text, ErrNotExecuted
+	}
+	return "", fmt.Errorf("unexpected message type %v", message.Type)
+}
+
+// ResponseString returns the response text of the command for logs and records,
+// the response to a binary command is returned in the same form as Command.String
+func (r *Command) ResponseString(message *teltonika.Message) string {
+	if r.Payload != nil {
+		return binaryPrefix + hex.EncodeToString(message.Bytes())
+	}
+	return message.Text
+}
+
+func noArgs(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("no arguments expected, got %d", len(args))
+	}
+	return nil
+}
+
+func validateReadIO(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected I/O element id")
+	}
+	if _, err := strconv.ParseUint(args[0], 10, 16); err != nil {
+		return fmt.Errorf("invalid I/O element id '%s'", args[0])
+	}
+	return nil
+}
+
+func validateSetDigOut(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 || len(args[0]) > 4 {
+		return fmt.Errorf("expected 1 to 4 output states")
+	}
+	for _, c := range args[0] {
+		if DigOut(c) != DigOutOff && DigOut(c) != DigOutOn && DigOut(c) != DigOutKeep {
+			return fmt.Errorf("invalid output state %q, expected 0, 1 or ?", c)
+		}
+	}
+	if len(args)-1 > len(args[0]) {
+		return fmt.Errorf("%d timeouts for %d outputs", len(args)-1, len(args[0]))
+	}
+	for _, it := range args[1:] {
+		if _, err := strconv.ParseUint(it, 10, 32); err != nil {
+			return fmt.Errorf("invalid timeout '%s'", it)
+		}
+	}
+	return nil
+}
+
+func validateSetParam(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected id:value pairs separated by ';'")
+	}
+	for _, pair := range strings.Split(args[0], ";") {
+		id, _, ok := strings.Cut(pair, ":")
+		if !ok {
+			return fmt.Errorf("invalid pair '%s', expected id:value", pair)
+		}
+		if _, err := strconv.ParseUint(id, 10, 32); err != nil {
+			return fmt.Errorf("invalid parameter id '%s'", id)
+		}
+	}
+	return nil
+}
+
+func validateGetParam(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected parameter ids separated by ';'")
+	}
+	for _, id := range strings.Split(args[0], ";") {
+		if _, err := strconv.ParseUint(id, 10, 32); err != nil {
+			return fmt.Errorf("invalid parameter id '%s'", id)
+		}
+	}
+	return nil
+}
+
+func asInterface[T any](parse func(text string) (T, error)) func(text string) (interface{}, error) {
+	return func(text string) (interface{}, error) {
+		return parse(text)
+	}
+}
@@ -0,0 +1,1301 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika"
+	"github.com/alim-zanibekov/teltonika/ioelements"
+	"github.com/alim-zanibekov/teltonika/params"
+)
+
+func TestBuilders(t *testing.T) {
+	cmd, err := SetDigOut([]DigOut{DigOutOn, DigOutKeep}, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.String() != "setdigout 1? 60" {
+		t.Errorf("unexpected command '%s'", cmd)
+	}
+	if _, err = SetDigOut([]DigOut{DigOutOn}, 1, 2); err == nil {
+		t.Error("more timeouts than outputs accepted")
+	}
+	if _, err = SetDigOut([]DigOut{'x'}); err == nil {
+		t.Error("invalid output state accepted")
+	}
+
+	cmd, err = SetParam(ParamValue{2001, "internet"}, ParamValue{2004, "my host"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.String() != "setparam 2001:internet;2004:my host" {
+		t.Errorf("unexpected command '%s'", cmd)
+	}
+	if _, err = SetParam(ParamValue{2001, "a;b"}); err == nil {
+		t.Error("value with ';' accepted")
+	}
+
+	cmd, err = GetParam(2001, 2002)
+	if err != nil || cmd.String() != "getparam 2001;2002" {
+		t.Errorf("unexpected command '%s' (%v)", cmd, err)
+	}
+	if ReadIO(21).String() != "readio 21" || GetVer().String() != "getver" {
+		t.Error("unexpected command text")
+	}
+
+	packet := GetInfo().Packet()
+	if packet.CodecID != teltonika.Codec12 || packet.Messages[0].Type != teltonika.TypeCommand ||
+		packet.Messages[0].Text != "getinfo" {
+		t.Errorf("unexpected packet %+v", packet)
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	cases := []struct {
+		text  string
+		valid bool
+	}{
+		{"getinfo", true}, {"  getver ", true}, {"getinfo 1", false}, {"", false},
+		{"deleterecords", true}, {"readio 21", true}, {"readio x", false}, {"readio 70000", false},
+		{"setdigout 1?0 10 20", true}, {"setdigout 12", false}, {"setdigout", false},
+		{"setparam 2001:internet;2004:my host", true}, {"setparam 2001", false}, {"setparam x:1", false},
+		{"getparam 2001;2002", true}, {"getparam 2001;", false}, {"getinfo\x00", false},
+		{"hex:00ff0a", true}, {"HEX:00FF", true}, {"hex:", false}, {"hex:0g", false},
+	}
+	for _, c := range cases {
+		_, err := ParseCommand(c.text)
+		if (err == nil) != c.valid {
+			t.Errorf("ParseCommand(%q) = %v, expected valid=%v", c.text, err, c.valid)
+		}
+	}
+	cmd, _ := ParseCommand("setparam 2004:my host")
+	if len(cmd.Args) != 1 || cmd.Args[0] != "2004:my host" {
+		t.Errorf("unexpected setparam args %v", cmd.Args)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	cmd := Binary([]byte{0x01, 0x03, 0x00, 0xff})
+	if cmd.String() != "hex:010300ff" {
+		t.Errorf("unexpected command text '%s'", cmd)
+	}
+	parsed, err := ParseCommand(cmd.String())
+	if err != nil || !reflect.DeepEqual(parsed, cmd) {
+		t.Errorf("unexpected parsed command %+v (%v)", parsed, err)
+	}
+	packet := cmd.Codec14Packet("352093081452251")
+	if string(packet.Messages[0].Bytes()) != "\x01\x03\x00\xff" || packet.Messages[0].Imei != "352093081452251" {
+		t.Errorf("unexpected packet %+v", packet)
+	}
+	if err = (&Command{Name: "x", Payload: []byte{1}}).Validate(); err == nil {
+		t.Error("binary command with a name accepted")
+	}
+
+	transport := &fakeTransport{}
+	var record *CommandRecord
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{OnComplete: func(it *CommandRecord) { record = it }})
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.HandlePacket(imei, &teltonika.Packet{CodecID: teltonika.Codec12, Messages: []teltonika.Message{
+			{Type: teltonika.TypeResponse, Payload: []byte{0x01, 0x03, 0x02, 0x00, 0x80}},
+		}})
+	}, false)
+	msg, err := dispatcher.Send(context.Background(), "1", cmd)
+	if err != nil || len(msg.Bytes()) != 5 {
+		t.Fatalf("unexpected response %+v (%v)", msg, err)
+	}
+	if record.Command != "hex:010300ff" || record.Response != "hex:0103020080" {
+		t.Errorf("unexpected record %+v", record)
+	}
+}
+
+func TestParseResponses(t *testing.T) {
+	ver, err := ParseVersion("Ver:03.27.07_00 GPS:AXN_5.1_1 Hw:FMB920 Mod:13 IMEI:352093086403655 " +
+		"Init:2019-10-22 13:49 Uptime:62 MAC:001E42BCF1A9 SPC:1(0) AXL:0 OBD:0 BL:1.7 BT:4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ver.Firmware != "03.27.07_00" || ver.Hardware != "FMB920" || ver.IMEI != "352093086403655" ||
+		ver.Values.str("Init") != "2019-10-22 13:49" {
+		t.Errorf("unexpected version %+v", ver)
+	}
+
+	status, err := ParseStatus("Data Link: 1 GPRS: 1 Phone: 0 SIM: 0 OP: 24602 Signal: 5 NewSMS: 0 " +
+		"Roaming: 0 SMSFull: 0 LAC: 1 Cell ID: 3055 NetType: 1 FwUpd:-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.DataLink || !status.GPRS || status.Operator != "24602" || status.Signal != 5 || status.CellID != 3055 {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	gps, err := ParseGPS("GPS:1 Sat:7 Lat:54.684254 Long:25.275888 Alt:116 Speed:0 Dir:170 " +
+		"Date: 2019/10/22 Time: 14:45:08")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gps.Valid || gps.Satellites != 7 || gps.Lat != 54.684254 || gps.Lng != 25.275888 ||
+		!gps.Time.Equal(time.Date(2019, 10, 22, 14, 45, 8, 0, time.UTC)) {
+		t.Errorf("unexpected gps %+v", gps)
+	}
+
+	info, err := ParseInfo("RTC:2019/10/22 14:45 Init:2019/10/22 13:49 UpTime:3362s PWR:PwrVoltage RST:0 " +
+		"GPS:3 SAT:7 TTFF:10 TTLF:0 NOGPS:0:0 SR:0 FG:0 FL:0 SMS:0 REC:42 MD:0 DB:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Uptime != 3362*time.Second || info.Records != 42 || info.Values.str("NOGPS") != "0:0" ||
+		info.Values.str("RTC") != "2019/10/22 14:45" {
+		t.Errorf("unexpected info %+v", info)
+	}
+
+	io, err := ParseIO("DI1:1 DI2:0 DI3:0 AIN1:12.5 AIN2:0 DO1:1 DO2:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !io.DigitalInputs[1] || io.DigitalInputs[2] || io.AnalogInputs[1] != 12.5 || !io.DigitalOutputs[1] {
+		t.Errorf("unexpected io %+v", io)
+	}
+
+	value, err := ParseReadIO("IO ID:21 Value:3")
+	if err != nil || *value != (IOValue{Id: 21, Value: "3"}) {
+		t.Errorf("unexpected readio %+v (%v)", value, err)
+	}
+
+	for text, expected := range map[string]map[uint32]string{
+		"Param ID:2001 Value:internet":               {2001: "internet"},
+		"New value 2001:internet;2004:my host;":      {2001: "internet", 2004: "my host"},
+		"2001:internet;2002:":                        {2001: "internet", 2002: ""},
+		"Param ID:2001 Value:a;Param ID:2002 Value:": {2001: "a", 2002: ""},
+	} {
+		res, err := ParseParams(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, expected) {
+			t.Errorf("ParseParams(%q) = %v, expected %v", text, res, expected)
+		}
+	}
+	if _, err = ParseKeyValues("garbage"); err == nil {
+		t.Error("garbage response parsed")
+	}
+
+	parsed, err := GetVer().ParseResponse("Ver:03.27.07_00 Hw:FMB920")
+	if _, ok := parsed.(*Version); !ok || err != nil {
+		t.Errorf("unexpected parsed response %T (%v)", parsed, err)
+	}
+}
+
+func TestResponseText(t *testing.T) {
+	if _, err := ResponseText(&teltonika.Message{Type: teltonika.TypeNotExecuted}); err != ErrNotExecuted {
+		t.Errorf("expected ErrNotExecuted, got %v", err)
+	}
+	if text, err := ResponseText(&teltonika.Message{Type: teltonika.TypeResponse, Text: "ok"}); err != nil || text != "ok" {
+		t.Errorf("unexpected response '%s' (%v)", text, err)
+	}
+}
+
+func TestBatchSetParam(t *testing.T) {
+	values := []ParamValue{{2001, "internet"}, {2002, "user"}, {2003, "password"}, {2004, "example.com"}}
+	res, err := BatchSetParam(values, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var texts []string
+	for _, it := range res {
+		if len(it.String()) > 40 {
+			t.Errorf("command '%s' exceeds the limit", it)
+		}
+		texts = append(texts, it.String())
+	}
+	expected := []string{"setparam 2001:internet;2002:user", "setparam 2003:password;2004:example.com"}
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("unexpected batches %q", texts)
+	}
+	if _, err = BatchSetParam([]ParamValue{{2004, "a-very-long-domain-name.example.com"}}, 40); err == nil {
+		t.Error("value exceeding the limit accepted")
+	}
+	res, _ = BatchGetParam([]uint32{2001, 2002, 2003}, 0)
+	if len(res) != 1 || res[0].String() != "getparam 2001;2002;2003" {
+		t.Errorf("unexpected getparam batches %v", res)
+	}
+}
+
+func TestApplyProfile(t *testing.T) {
+	// the device ignores the first write of 2002
+	device := map[uint32]string{2001: "", 2002: "", 2003: ""}
+	skipped := false
+	var sent []string
+	exec := func(cmd *Command) (string, error) {
+		sent = append(sent, cmd.String())
+		if cmd.Name == "getparam" {
+			var parts []string
+			for _, id := range strings.Split(cmd.Args[0], ";") {
+				n, _ := strconv.Atoi(id)
+				parts = append(parts, fmt.Sprintf("%s:%s", id, device[uint32(n)]))
+			}
+			return strings.Join(parts, ";"), nil
+		}
+		values, err := ParseParams(cmd.Args[0])
+		if err != nil {
+			return "", err
+		}
+		for id, value := range values {
+			if id == 2002 && !skipped {
+				skipped = true
+				continue
+			}
+			device[id] = value
+		}
+		return "New value " + cmd.Args[0], nil
+	}
+
+	profile := &Profile{Name: "apn", Params: []ParamValue{{2001, "internet"}, {2002, "user"}, {2001, "iot"}}}
+	report, err := ApplyProfile(exec, profile, &ApplyConfig{Retries: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Compliant || report.Attempts != 2 || len(report.Params) != 2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if report.Params[0].Actual != "iot" || report.Params[1].Attempts != 2 {
+		t.Errorf("unexpected params %+v", report.Params)
+	}
+	if sent[len(sent)-2] != "setparam 2002:user" {
+		t.Errorf("unexpected retry commands %q", sent)
+	}
+
+	report, err = ApplyProfile(func(cmd *Command) (string, error) {
+		if cmd.Name == "getparam" {
+			return "2003:other", nil
+		}
+		return "", nil
+	}, &Profile{Name: "x", Params: []ParamValue{{2003, "a"}}})
+	if err != nil || report.Compliant || report.Params[0].Actual != "other" {
+		t.Errorf("unexpected report %+v (%v)", report, err)
+	}
+
+	report, err = ApplyProfile(func(cmd *Command) (string, error) {
+		return "", fmt.Errorf("tracker disconnected")
+	}, &Profile{Name: "x", Params: []ParamValue{{2003, "a"}}})
+	if err == nil || report.Error == "" || report.Compliant {
+		t.Errorf("executor error not reported %+v", report)
+	}
+}
+
+func TestProfileValidate(t *testing.T) {
+	catalogue := params.NewCatalogue([]params.ParamDefinition{
+		{Id: 102, Type: params.ParamUint8, Min: 0, Max: 4, SupportedModels: []string{"FMB920"}},
+	})
+	profile := &Profile{Name: "sleep", Params: []ParamValue{{102, "2"}}}
+	if err := profile.Validate(catalogue, "FMB920"); err != nil {
+		t.Error(err)
+	}
+	profile.Params[0].Value = "5"
+	if err := profile.Validate(catalogue, "FMB920"); err == nil {
+		t.Error("out of range value accepted")
+	}
+	if err := profile.Validate(nil, ""); err != nil {
+		t.Error(err)
+	}
+	profile.Params[0].Value = "a;b"
+	if err := profile.Validate(nil, ""); err == nil {
+		t.Error("value with ';' accepted")
+	}
+}
+
+func TestReadParams(t *testing.T) {
+	device := params.Values{2001: "internet", 2002: "user"}
+	exec := func(cmd *Command) (string, error) {
+		var parts []string
+		for _, id := range strings.Split(cmd.Args[0], ";") {
+			n, _ := strconv.Atoi(id)
+			parts = append(parts, fmt.Sprintf("Param ID:%s Value:%s", id, device[uint32(n)]))
+		}
+		return strings.Join(parts, ";"), nil
+	}
+	desired := params.Values{2001: "internet", 2002: "admin"}
+	current, err := ReadParams(exec, desired.Ids(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := params.Diff(current, desired)
+	if len(changes) != 1 || changes[0].Id != 2002 {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+
+	profile := NewProfile("cfg", desired)
+	if !reflect.DeepEqual(profile.Params, []ParamValue{{2001, "internet"}, {2002, "admin"}}) {
+		t.Errorf("unexpected profile %+v", profile)
+	}
+	if !reflect.DeepEqual(profile.Values(), desired) {
+		t.Errorf("unexpected profile values %v", profile.Values())
+	}
+}
+
+type fakeTransport struct {
+	mu      sync.Mutex
+	sent    []*teltonika.Packet
+	onSend  func(imei string, packet *teltonika.Packet)
+	offline bool
+}
+
+func (r *fakeTransport) SendPacket(imei string, packet *teltonika.Packet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.offline {
+		return fmt.Errorf("client with imei '%s' not found", imei)
+	}
+	r.sent = append(r.sent, packet)
+	if r.onSend != nil {
+		go r.onSend(imei, packet)
+	}
+	return nil
+}
+
+func (r *fakeTransport) set(onSend func(imei string, packet *teltonika.Packet), offline bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onSend, r.offline = onSend, offline
+}
+
+func response(codec teltonika.CodecId, typ teltonika.MessageType, imei, text string) *teltonika.Packet {
+	return &teltonika.Packet{CodecID: codec, Messages: []teltonika.Message{{Type: typ, Imei: imei, Text: text}}}
+}
+
+func TestDispatcherFIFO(t *testing.T) {
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport)
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		// echo the command text, only one command is in flight at a time
+		if dispatcher.Pending(imei) == 0 {
+			t.Error("no pending commands")
+		}
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "re: "+packet.Messages[0].Text))
+	}, false)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			msg, err := dispatcher.Send(context.Background(), "1", ReadIO(uint16(i)))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if msg.Text != fmt.Sprintf("re: readio %d", i) {
+				t.Errorf("response '%s' matched to 'readio %d'", msg.Text, i)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(transport.sent) != 20 || dispatcher.Pending("1") != 0 {
+		t.Errorf("unexpected state: sent %d, pending %d", len(transport.sent), dispatcher.Pending("1"))
+	}
+	if dispatcher.HandlePacket("1", response(teltonika.Codec12, teltonika.TypeResponse, "", "unsolicited")) {
+		t.Error("response without a command matched")
+	}
+}
+
+func TestDispatcherCodec14(t *testing.T) {
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport)
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		if packet.CodecID != teltonika.Codec14 || packet.Messages[0].Imei != imei {
+			t.Errorf("unexpected packet %+v", packet)
+		}
+		// Codec 12 response and response with other imei are not matched
+		if dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "x")) ||
+			dispatcher.HandlePacket(imei, response(teltonika.Codec14, teltonika.TypeResponse, "352093081452250", "x")) {
+			t.Error("foreign response matched")
+		}
+		if imei == "352093081452251" {
+			dispatcher.HandlePacket(imei, response(teltonika.Codec14, teltonika.TypeResponse, "0"+imei, "ok"))
+		} else {
+			dispatcher.HandlePacket(imei, response(teltonika.Codec14, teltonika.TypeNotExecuted, "352093081452251", ""))
+		}
+	}, false)
+
+	msg, err := dispatcher.Send(context.Background(), "352093081452251", GetVer(), &SendConfig{Codec14: true})
+	if err != nil || msg.Text != "ok" {
+		t.Errorf("unexpected response %+v (%v)", msg, err)
+	}
+	msg, err = dispatcher.Send(context.Background(), "352093081452252", GetVer(), &SendConfig{Codec14: true})
+	if err != ErrNotExecuted || msg == nil || msg.Type != teltonika.TypeNotExecuted {
+		t.Errorf("expected ErrNotExecuted, got %+v (%v)", msg, err)
+	}
+}
+
+func TestDispatcherErrors(t *testing.T) {
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{LateResponseWindow: time.Second})
+
+	if _, err := dispatcher.Send(context.Background(), "1", GetVer(), &SendConfig{Timeout: time.Millisecond * 10}); err != ErrTimeout {
+		t.Errorf("expected ErrTimeout, got %v", err)
+	}
+	// the late response to the timed out command is dropped, the next one is matched
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "late"))
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "ok"))
+	}, false)
+	if msg, err := dispatcher.Send(context.Background(), "1", GetInfo()); err != nil || msg.Text != "ok" {
+		t.Errorf("unexpected response %+v (%v)", msg, err)
+	}
+
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.Disconnected(imei)
+	}, false)
+	if _, err := dispatcher.Send(context.Background(), "2", GetVer()); err != ErrDisconnected {
+		t.Errorf("expected ErrDisconnected, got %v", err)
+	}
+
+	transport.set(nil, false)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	if _, err := dispatcher.Send(ctx, "3", GetVer()); err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	transport.set(nil, true)
+	if _, err := dispatcher.Send(context.Background(), "4", GetVer()); err == nil {
+		t.Error("offline device error not returned")
+	}
+	if _, err := dispatcher.Send(context.Background(), "4", New("getinfo", "x")); err == nil {
+		t.Error("invalid command sent")
+	}
+}
+
+func TestDispatcherLateResponse(t *testing.T) {
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{Timeout: time.Second, LateResponseWindow: time.Minute})
+	short := &SendConfig{Timeout: time.Millisecond * 10}
+
+	// a fast reply to the command sent after a timeout is matched, not taken for the late answer
+	if _, err := dispatcher.Send(context.Background(), "1", GetVer(), short); err != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "Ver:03.27.07_00 Hw:FMB920"))
+	}, false)
+	if msg, err := dispatcher.Send(context.Background(), "1", GetVer()); err != nil || msg.Text != "Ver:03.27.07_00 Hw:FMB920" {
+		t.Errorf("unexpected response %+v (%v)", msg, err)
+	}
+
+	// the late answer received while no command is in flight closes the window
+	transport.set(nil, false)
+	if _, err := dispatcher.Send(context.Background(), "2", GetVer(), short); err != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	if dispatcher.HandlePacket("2", response(teltonika.Codec12, teltonika.TypeResponse, "", "Ver:03.27.07_00")) {
+		t.Error("late response matched")
+	}
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "unknown"))
+	}, false)
+	if msg, err := dispatcher.Send(context.Background(), "2", GetInfo()); err != nil || msg.Text != "unknown" {
+		t.Errorf("unexpected response %+v (%v)", msg, err)
+	}
+}
+
+func TestOfflineQueue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.jsonl")
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{Timeout: time.Millisecond * 20})
+	queue, err := OpenOfflineQueue(path, dispatcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	low, _ := queue.Enqueue("1", GetVer())
+	high, _ := queue.Enqueue("1", GetInfo(), &EnqueueOptions{Priority: 10})
+	expired, _ := queue.Enqueue("1", GetGPS(), &EnqueueOptions{TTL: time.Nanosecond})
+	other, _ := queue.Enqueue("2", GetVer())
+	if _, err = queue.Enqueue("1", New("readio", "x")); err == nil {
+		t.Error("invalid command queued")
+	}
+	if err = queue.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// reopen, the state is restored from the file
+	queue, err = OpenOfflineQueue(path, dispatcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = queue.Close() }()
+	if len(queue.List("")) != 4 || len(queue.List("1")) != 3 {
+		t.Fatalf("unexpected queue %v", queue.List(""))
+	}
+
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "re: "+packet.Messages[0].Text))
+	}, false)
+	if err = queue.Deliver(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+	texts := make([]string, 0)
+	for _, it := range transport.sent {
+		texts = append(texts, it.Messages[0].Text)
+	}
+	if !reflect.DeepEqual(texts, []string{"getinfo", "getver"}) {
+		t.Errorf("unexpected delivery order %q", texts)
+	}
+	for id, status := range map[uint64]QueueStatus{
+		low.Id: StatusAnswered, high.Id: StatusAnswered, expired.Id: StatusExpired, other.Id: StatusQueued,
+	} {
+		if it, _ := queue.Get(id); it.Status != status {
+			t.Errorf("command %d: expected status %s, got %+v", id, status, it)
+		}
+	}
+	if it, _ := queue.Get(high.Id); it.Response != "re: getinfo" || it.Attempts != 1 {
+		t.Errorf("unexpected command %+v", it)
+	}
+
+	// the device does not answer: the command returns to the queue until attempts are exhausted
+	transport.set(nil, false)
+	for i := 0; i < 3; i++ {
+		if err = queue.Deliver(context.Background(), "2"); err != ErrTimeout && i < 2 {
+			t.Errorf("expected ErrTimeout, got %v", err)
+		}
+	}
+	if it, _ := queue.Get(other.Id); it.Status != StatusFailed || it.Attempts != 3 {
+		t.Errorf("unexpected command %+v", it)
+	}
+}
+
+func TestOfflineQueueCorrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.jsonl")
+	record := `{"id":1,"imei":"1","command":"getver","status":"queued","maxAttempts":3,"expiresAt":"2100-01-01T00:00:00Z"}`
+	// torn last line is ignored
+	if err := os.WriteFile(path, []byte(record+"\n{\"id\":2,"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	queue, err := OpenOfflineQueue(path, NewDispatcher(&fakeTransport{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.List("")) != 1 {
+		t.Errorf("unexpected queue %v", queue.List(""))
+	}
+	_ = queue.Close()
+
+	if err = os.WriteFile(path, []byte("{\"id\":2,\n"+record+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = OpenOfflineQueue(path, NewDispatcher(&fakeTransport{})); err == nil {
+		t.Error("corrupted store opened")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	audit, err := OpenAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{
+		Timeout: time.Millisecond * 20,
+		OnComplete: func(record *CommandRecord) {
+			if err := audit.Record(record); err != nil {
+				t.Error(err)
+			}
+		},
+	})
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		if imei == "1" {
+			dispatcher.HandlePacket(imei, response(packet.CodecID, teltonika.TypeResponse, imei, "ok"))
+		}
+	}, false)
+
+	_, _ = dispatcher.Send(context.Background(), "1", GetVer(), &SendConfig{Requester: "alice"})
+	_, _ = dispatcher.Send(context.Background(), "2", mustSetDigOut(t), &SendConfig{Requester: "bob", Codec14: true})
+	_, _ = dispatcher.Send(context.Background(), "1", GetInfo(), &SendConfig{Requester: "bob"})
+	if err = audit.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// torn write of the last line
+	file, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	_, _ = file.WriteString(`{"id":4,`)
+	_ = file.Close()
+
+	audit, err = OpenAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = audit.Close() }()
+	if err = audit.Record(&CommandRecord{Imei: "3", Command: "getver", QueuedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := audit.Query(AuditFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 4 || all[3].Id != 4 {
+		t.Fatalf("unexpected records %+v", all)
+	}
+	first := all[0]
+	if first.Requester != "alice" || first.Response != "ok" || first.SentAt == nil || first.AnsweredAt == nil ||
+		first.Codec != teltonika.Codec12 {
+		t.Errorf("unexpected record %+v", first)
+	}
+	if all[1].Error != ErrTimeout.Error() || all[1].AnsweredAt != nil || all[1].Codec != teltonika.Codec14 {
+		t.Errorf("unexpected record %+v", all[1])
+	}
+
+	bob, _ := audit.Query(AuditFilter{Requester: "bob", Limit: 1})
+	if len(bob) != 1 || bob[0].Command != "getinfo" {
+		t.Errorf("unexpected records %+v", bob)
+	}
+	imei, _ := audit.Query(AuditFilter{Imei: "1", To: first.QueuedAt.Add(time.Nanosecond)})
+	if len(imei) != 1 || imei[0].Id != first.Id {
+		t.Errorf("unexpected records %+v", imei)
+	}
+
+	buf := &strings.Builder{}
+	if err = WriteAuditCsv(buf, all[:1]); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[1], "1,alice,1,12,getver,") {
+		t.Errorf("unexpected csv %q", lines)
+	}
+}
+
+func mustSetDigOut(t *testing.T) *Command {
+	cmd, err := SetDigOut([]DigOut{DigOutOn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestInterlock(t *testing.T) {
+	maxSpeed := uint16(5)
+	interlock := NewInterlock([]InterlockRule{{
+		Name: "immobiliser", Commands: []string{"setdigout"}, MaxSpeed: &maxSpeed, IgnitionOff: true, RequireFix: true,
+		Wait: time.Millisecond * 200,
+	}})
+	cmd := mustSetDigOut(t)
+	base := time.Now().Add(-time.Second * 10).UnixMilli()
+	if d := interlock.Check("1", GetVer()); !d.Allowed || d.Rule != "" {
+		t.Errorf("command without rules is not allowed %+v", d)
+	}
+	if d := interlock.Check("1", cmd); d.Allowed || d.Reasons[0] != "vehicle state is unknown" {
+		t.Errorf("unexpected decision %+v", d)
+	}
+
+	moving := &teltonika.Packet{CodecID: teltonika.Codec8E, Data: []teltonika.Data{
+		{TimestampMs: uint64(base + 2000), Speed: 60, Satellites: 9, Lat: 54.6, Lng: 25.2,
+			Elements: []teltonika.IOElement{{Id: ioelements.IgnitionID, Value: []byte{1}}}},
+		{TimestampMs: uint64(base + 1000), Speed: 0},
+	}}
+	interlock.Update("1", moving)
+	d := interlock.Check("1", cmd)
+	if d.Allowed || len(d.Reasons) != 2 || d.State.Speed != 60 {
+		t.Errorf("unexpected decision %+v", d)
+	}
+
+	// the vehicle stops while the command waits
+	go func() {
+		time.Sleep(time.Millisecond * 20)
+		interlock.SetState("1", &VehicleState{Timestamp: time.UnixMilli(base + 1500), Speed: 0, Satellites: 9, Lat: 1, Lng: 1})
+		time.Sleep(time.Millisecond * 20)
+		ignition := false
+		interlock.SetState("1", &VehicleState{Timestamp: time.UnixMilli(base + 3000), Speed: 0, Satellites: 9, Lat: 1, Lng: 1, Ignition: &ignition})
+	}()
+	d, err := interlock.Await(context.Background(), "1", cmd)
+	if err != nil || !d.Allowed || d.Waited == 0 {
+		t.Errorf("unexpected decision %+v (%v)", d, err)
+	}
+
+	transport := &fakeTransport{}
+	var records []*CommandRecord
+	var mu sync.Mutex
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{
+		Interlock: interlock, Timeout: time.Millisecond * 10,
+		OnComplete: func(record *CommandRecord) {
+			mu.Lock()
+			records = append(records, record)
+			mu.Unlock()
+		},
+	})
+	interlock.Update("2", moving)
+	_, err = dispatcher.Send(context.Background(), "2", cmd)
+	var interlockErr *InterlockError
+	if !errors.As(err, &interlockErr) || interlockErr.Decision.Rule != "immobiliser" {
+		t.Errorf("expected InterlockError, got %v", err)
+	}
+	if len(transport.sent) != 0 {
+		t.Error("blocked command sent")
+	}
+	mu.Lock()
+	if len(records) != 1 || records[0].Interlock == nil || records[0].Interlock.Allowed || records[0].SentAt != nil {
+		t.Errorf("unexpected records %+v", records)
+	}
+	mu.Unlock()
+
+	// the vehicle starts moving while the allowed command waits in the queue
+	ignition := false
+	interlock.SetState("3", &VehicleState{Timestamp: time.UnixMilli(base + 5000), Satellites: 9, Lat: 1, Lng: 1, Ignition: &ignition})
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		if packet.Messages[0].Text != "getver" {
+			return
+		}
+		for dispatcher.Pending(imei) < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		interlock.SetState(imei, &VehicleState{Timestamp: time.UnixMilli(base + 6000), Speed: 60, Satellites: 9, Lat: 1, Lng: 1, Ignition: &ignition})
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "Ver:1"))
+	}, false)
+	go func() {
+		_, _ = dispatcher.Send(context.Background(), "3", GetVer())
+	}()
+	for dispatcher.Pending("3") < 1 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err = dispatcher.Send(context.Background(), "3", cmd); !errors.As(err, &interlockErr) {
+		t.Errorf("expected InterlockError, got %v", err)
+	}
+	transport.mu.Lock()
+	if len(transport.sent) != 1 {
+		t.Errorf("blocked command sent %+v", transport.sent)
+	}
+	transport.mu.Unlock()
+
+	// stale states and speed without a fix are not trusted
+	interlock.SetRules([]InterlockRule{{Name: "speed", Commands: []string{"setdigout"}, MaxSpeed: &maxSpeed}})
+	interlock.SetState("4", &VehicleState{Timestamp: time.Now().Add(-DefaultInterlockStateAge * 2), Satellites: 9, Lat: 1, Lng: 1})
+	if d := interlock.Check("4", cmd); d.Allowed || d.Reasons[0] != "vehicle state is older than 5m0s" {
+		t.Errorf("stale state is trusted %+v", d)
+	}
+	interlock.SetState("4", &VehicleState{Timestamp: time.Now()})
+	if d := interlock.Check("4", cmd); d.Allowed || d.Reasons[0] != "no GNSS fix (0 satellites)" {
+		t.Errorf("speed without a fix is trusted %+v", d)
+	}
+
+	binary := Binary([]byte{1})
+	if d := interlock.Check("3", binary); d.Allowed || d.Rule != InterlockBinary {
+		t.Errorf("binary command without a rule is allowed %+v", d)
+	}
+	interlock.SetRules(append(interlock.Rules(), InterlockRule{Name: "serial", Commands: []string{InterlockBinary}}))
+	if d := interlock.Check("3", binary); !d.Allowed || d.Rule != "serial" {
+		t.Errorf("unexpected decision %+v", d)
+	}
+}
+
+func TestRequester(t *testing.T) {
+	users := map[string]string{"alice": "secret"}
+	cases := []struct {
+		user, password, header, expected string
+	}{
+		{"alice", "secret", "", "alice"},
+		{"alice", "wrong", "", "alice (unverified, 10.0.0.1:5000)"},
+		{"bob", "secret", "", "bob (unverified, 10.0.0.1:5000)"},
+		{"", "", "carol", "carol (unverified, 10.0.0.1:5000)"},
+		{"", "", "", "10.0.0.1:5000"},
+	}
+	for _, it := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/cmd", nil)
+		r.RemoteAddr = "10.0.0.1:5000"
+		if it.user != "" {
+			r.SetBasicAuth(it.user, it.password)
+		}
+		if it.header != "" {
+			r.Header.Set("X-Requester", it.header)
+		}
+		if res := Requester(r, users); res != it.expected {
+			t.Errorf("expected %q, got %q", it.expected, res)
+		}
+	}
+}
+
+func TestReadCommand(t *testing.T) {
+	cases := []struct {
+		format, body, expected string
+		payload                []byte
+	}{
+		{"", " getver ", "getver", nil},
+		{"hex", "0102ff", "", []byte{1, 2, 0xff}},
+		{"base64", "AQI=", "", []byte{1, 2}},
+		{"binary", "\x01", "", []byte{1}},
+	}
+	for _, it := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/cmd?format="+it.format, strings.NewReader(it.body))
+		cmd, err := ReadCommand(r)
+		if err != nil || it.payload == nil && cmd.String() != it.expected || !bytes.Equal(cmd.Payload, it.payload) {
+			t.Errorf("%s: unexpected command %+v (%v)", it.format, cmd, err)
+		}
+	}
+	for _, r := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/cmd?format=hex", strings.NewReader("zz")),
+		httptest.NewRequest(http.MethodPost, "/cmd?format=xml", strings.NewReader("getver")),
+		httptest.NewRequest(http.MethodPost, "/cmd", strings.NewReader(strings.Repeat("a", MaxCommandSize+1))),
+	} {
+		if _, err := ReadCommand(r); err == nil {
+			t.Errorf("%s: invalid command accepted", r.URL)
+		}
+	}
+}
+
+func TestCommandResult(t *testing.T) {
+	res := CommandResult(GetVer(), &teltonika.Message{Type: teltonika.TypeResponse, Text: "Ver:03.27.07_00 GPS:AXN_5.10_3333 Hw:FMB920 Mod:13 IMEI:352093081452251 Init:2019-11-8 11:6 Uptime:17 MAC:60C5A86B3CEE SPC:1(0) AXL:0 OBD:0 BL:1.10 BT:4"}, "")
+	if res["response"] == nil || res["parsed"] == nil || res["payload"] != nil {
+		t.Errorf("unexpected result %+v", res)
+	}
+	res = CommandResult(Binary([]byte{1}), &teltonika.Message{Type: teltonika.TypeResponse, Text: "\x01\x02"}, "base64")
+	if res["payload"] != "AQI=" || res["response"] != nil {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestInterlockRuleJson(t *testing.T) {
+	text := `[{"name":"immobiliser","commands":["setdigout"],"maxSpeed":5,"ignitionOff":true,"maxStateAge":"2m","wait":"10m"}]`
+	var rules []InterlockRule
+	if err := json.Unmarshal([]byte(text), &rules); err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || *rules[0].MaxSpeed != 5 || rules[0].MaxStateAge != time.Minute*2 || rules[0].Wait != time.Minute*10 {
+		t.Fatalf("unexpected rules %+v", rules)
+	}
+	data, _ := json.Marshal(rules)
+	if string(data) != `[{"name":"immobiliser","commands":["setdigout"],"maxSpeed":5,"ignitionOff":true,"maxStateAge":"2m0s","wait":"10m0s"}]` {
+		t.Errorf("unexpected json %s", data)
+	}
+	if err := json.Unmarshal([]byte(`[{"wait":"x"}]`), &rules); err == nil {
+		t.Error("invalid duration accepted")
+	}
+
+	data, _ = json.Marshal(&InterlockDecision{Rule: "immobiliser", Reasons: []string{"ignition is on"}, Waited: time.Minute * 10})
+	if string(data) != `{"allowed":false,"rule":"immobiliser","reasons":["ignition is on"],"waited":"10m0s"}` {
+		t.Errorf("unexpected json %s", data)
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 7, 30, 0, time.UTC) // Friday
+	cases := []struct {
+		spec string
+		next time.Time
+	}{
+		{"*/15 * * * *", time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)},
+		{"0 3 * * mon", time.Date(2024, 3, 4, 3, 0, 0, 0, time.UTC)},
+		{"30 9-17/4 * * *", time.Date(2024, 3, 1, 13, 30, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * 7", time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", base.Add(time.Minute * 90)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, c := range cases {
+		schedule, err := ParseSchedule(c.spec)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", c.spec, err)
+			continue
+		}
+		if next := schedule.Next(base); !next.Equal(c.next) {
+			t.Errorf("ParseSchedule(%q).Next = %v, expected %v", c.spec, next, c.next)
+		}
+	}
+	for _, it := range []string{"* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "@every 1ms", "0 0 * foo *"} {
+		if _, err := ParseSchedule(it); err == nil {
+			t.Errorf("invalid schedule %q parsed", it)
+		}
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	dir := t.TempDir()
+	transport := &fakeTransport{}
+	dispatcher := NewDispatcher(transport)
+	var scheduler *Scheduler
+	queue, err := OpenOfflineQueue(filepath.Join(dir, "queue.jsonl"), dispatcher, &QueueConfig{
+		OnFinish: func(it QueuedCommand) { scheduler.HandleFinished(it) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = queue.Close() }()
+
+	devices := []Device{
+		{Imei: "1", Model: "FMB920", Tags: []string{"trucks"}},
+		{Imei: "2", Model: "FMC650"},
+		{Imei: "3", Model: "FMB920"},
+	}
+	jobs := []Job{
+		{Name: "weekly-ver", Schedule: "@weekly", Command: "getver", Targets: DeviceSet{All: true}},
+		{Name: "gps", Schedule: "@every 1h", Command: "getgps", Targets: DeviceSet{Tags: []string{"trucks"}, Models: []string{"fmc650"}}},
+	}
+	scheduler, err = NewScheduler(queue, jobs, SchedulerConfig{
+		Devices: func() []Device { return devices }, ResultsPath: filepath.Join(dir, "results.jsonl"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = scheduler.Close() }()
+
+	if n, err := scheduler.RunJob("gps"); err != nil || n != 2 {
+		t.Fatalf("unexpected run result %d (%v)", n, err)
+	}
+	// commands of the previous run are still queued
+	if n, _ := scheduler.RunJob("gps"); n != 0 {
+		t.Errorf("devices with queued commands are not skipped, queued %d", n)
+	}
+	if n, _ := scheduler.RunJob("weekly-ver"); n != 3 {
+		t.Errorf("unexpected number of queued commands %d", n)
+	}
+	if _, err = scheduler.RunJob("unknown"); err == nil {
+		t.Error("unknown job run")
+	}
+
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		dispatcher.HandlePacket(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", imei+": "+packet.Messages[0].Text))
+	}, false)
+	if err = queue.Deliver(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+	results, err := scheduler.Results("gps", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Imei != "1" || results[0].Response != "1: getgps" || results[0].Status != StatusAnswered {
+		t.Errorf("unexpected results %+v", results)
+	}
+	if all, _ := scheduler.Results("", 0); len(all) != 2 {
+		t.Errorf("unexpected results %+v", all)
+	}
+
+	statuses := scheduler.Jobs()
+	if len(statuses) != 2 || statuses[1].Queued != 0 || statuses[1].LastRun.IsZero() || statuses[0].Next.Weekday() != time.Sunday {
+		t.Errorf("unexpected statuses %+v", statuses)
+	}
+
+	for _, it := range [][]Job{
+		{{Name: "x", Schedule: "bad", Command: "getver"}},
+		{{Name: "x", Schedule: "@daily", Command: "readio x"}},
+		{{Name: "x", Schedule: "@daily", Command: "getver"}, {Name: "x", Schedule: "@daily", Command: "getver"}},
+	} {
+		if _, err = NewScheduler(queue, it, SchedulerConfig{Devices: func() []Device { return nil }}); err == nil {
+			t.Errorf("invalid jobs accepted %+v", it)
+		}
+	}
+}
+
+func TestTunnel(t *testing.T) {
+	transport := &fakeTransport{}
+	tunnels := NewTunnels(transport, &TunnelConfig{ChunkSize: 4, BufferSize: 8})
+	tunnel, err := tunnels.Open("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conn net.Conn = tunnel
+	if _, err = tunnels.Open("1"); err != ErrTunnelBusy {
+		t.Errorf("expected ErrTunnelBusy, got %v", err)
+	}
+
+	if n, err := conn.Write([]byte("0123456789")); err != nil || n != 10 {
+		t.Fatalf("unexpected write result %d (%v)", n, err)
+	}
+	if len(transport.sent) != 3 || string(transport.sent[2].Messages[0].Payload) != "89" {
+		t.Errorf("unexpected packets %+v", transport.sent)
+	}
+
+	if tunnels.HandlePacket("2", response(teltonika.Codec12, teltonika.TypeResponse, "", "x")) ||
+		tunnels.HandlePacket("1", response(teltonika.Codec14, teltonika.TypeResponse, "1", "x")) {
+		t.Error("foreign packet consumed")
+	}
+	tunnels.HandlePacket("1", response(teltonika.Codec12, teltonika.TypeResponse, "", "abcdef"))
+	tunnels.HandlePacket("1", response(teltonika.Codec12, teltonika.TypeResponse, "", "ghij"))
+	buf := make([]byte, 16)
+	if n, err := conn.Read(buf); err != nil || string(buf[:n]) != "cdefghij" || tunnel.Dropped() != 2 {
+		t.Errorf("unexpected read result '%s' (%v), dropped %d", buf[:n], err, tunnel.Dropped())
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(20 * time.Millisecond))
+	if _, err = conn.Read(buf); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("expected deadline error, got %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = conn.Close()
+	}()
+	if _, err = conn.Read(buf); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+	if _, err = conn.Write([]byte("x")); err != net.ErrClosed || tunnels.IsOpen("1") {
+		t.Errorf("tunnel is not closed (%v)", err)
+	}
+}
+
+func TestTunnelListen(t *testing.T) {
+	transport := &fakeTransport{}
+	tunnels := NewTunnels(transport)
+	// the device echoes the serial data
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		tunnels.HandlePacket(imei, &teltonika.Packet{CodecID: teltonika.Codec12, Messages: []teltonika.Message{
+			{Type: teltonika.TypeResponse, Payload: packet.Messages[0].Payload},
+		}})
+	}, false)
+	listener, err := tunnels.Listen("1", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = listener.Close() }()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(time.Second))
+	if _, err = conn.Write([]byte{0x01, 0x03, 0x00}); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 3)
+	if _, err = io.ReadFull(conn, buf); err != nil || string(buf) != "\x01\x03\x00" {
+		t.Errorf("unexpected echo %v (%v)", buf, err)
+	}
+	_ = conn.Close()
+	for i := 0; i < 100 && tunnels.IsOpen("1"); i++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if tunnels.IsOpen("1") {
+		t.Error("tunnel is not closed with the connection")
+	}
+
+	// closing the tunnel closes the connection piped to it
+	if conn, err = net.Dial("tcp", listener.Addr().String()); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(time.Second))
+	for i := 0; i < 100 && !tunnels.IsOpen("1"); i++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if !tunnels.Close("1") || tunnels.Close("1") {
+		t.Error("unexpected close result")
+	}
+	if _, err = conn.Read(buf); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+	_ = conn.Close()
+}
+
+func TestTunnelDispatcher(t *testing.T) {
+	transport := &fakeTransport{}
+	tunnels := NewTunnels(transport)
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{Tunnels: tunnels, Timeout: time.Second})
+	handle := func(imei string, packet *teltonika.Packet) bool {
+		return dispatcher.HandlePacket(imei, packet) || tunnels.HandlePacket(imei, packet)
+	}
+
+	tunnel, err := tunnels.Open("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dispatcher.Send(context.Background(), "1", GetVer()); err != ErrTunnelOpen {
+		t.Errorf("expected ErrTunnelOpen, got %v", err)
+	}
+	if len(transport.sent) != 0 {
+		t.Error("command sent while the tunnel is open")
+	}
+	_ = tunnel.Close()
+
+	// the tunnel is opened while the command waits for the response
+	buf := make([]byte, 16)
+	transport.set(func(imei string, packet *teltonika.Packet) {
+		if tunnel, err = tunnels.Open(imei); err != nil {
+			t.Error(err)
+			return
+		}
+		handle(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "Ver:1"))
+		handle(imei, response(teltonika.Codec12, teltonika.TypeResponse, "", "serial"))
+	}, false)
+	if msg, err := dispatcher.Send(context.Background(), "1", GetVer()); err != nil || msg.Text != "Ver:1" {
+		t.Errorf("unexpected response %+v (%v)", msg, err)
+	}
+	if n, err := tunnel.Read(buf); err != nil || string(buf[:n]) != "serial" {
+		t.Errorf("unexpected read result '%s' (%v)", buf[:n], err)
+	}
+}
+
+func TestUnsolicitedHandler(t *testing.T) {
+	var messages []*DeviceMessage
+	var errs []error
+	handler := &UnsolicitedHandler{
+		Sink:    func(message *DeviceMessage) { messages = append(messages, message) },
+		OnError: func(err error) { errs = append(errs, err) },
+	}
+	if handler.HandlePacket("352093081452251", response(teltonika.Codec12, teltonika.TypeResponse, "", "x")) {
+		t.Error("command response consumed")
+	}
+	if !handler.HandlePacket("352093081452251", &teltonika.Packet{CodecID: teltonika.Codec13, Messages: []teltonika.Message{
+		{Type: teltonika.TypeResponse, Timestamp: 1699440036, Text: "alarm"},
+	}}) {
+		t.Error("codec 13 packet is not consumed")
+	}
+	handler.HandlePacket("352093081452251", &teltonika.Packet{CodecID: teltonika.Codec15, Messages: []teltonika.Message{
+		{Type: 0x0B, Timestamp: 1699440036, Imei: "352093081452251", Text: "Hello!"},
+		{Type: 0x0B, Timestamp: 1699440036, Imei: "123456789123456", Text: "spoofed"},
+	}})
+	if len(messages) != 2 || messages[0].Codec != teltonika.Codec13 || messages[1].Text != "Hello!" ||
+		!messages[1].Timestamp.Equal(time.Date(2023, 11, 8, 10, 40, 36, 0, time.UTC)) || messages[1].Imei != "352093081452251" {
+		t.Errorf("unexpected messages %+v", messages)
+	}
+	var mismatch *ImeiMismatchError
+	if len(errs) != 1 || !errors.As(errs[0], &mismatch) || mismatch.Message != "123456789123456" {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
+
+func TestSMSFallback(t *testing.T) {
+	if text, err := FormatSMS(&SMSDevice{}, GetGPS()); err != nil || text != "  getgps" {
+		t.Errorf("unexpected SMS text '%s' (%v)", text, err)
+	}
+	if text, _ := FormatSMS(&SMSDevice{Login: "admin", Password: "secret"}, ReadIO(21)); text != "admin secret readio 21" {
+		t.Errorf("unexpected SMS text '%s'", text)
+	}
+	if _, err := FormatSMS(&SMSDevice{}, Binary([]byte{1})); err == nil {
+		t.Error("binary command formatted as SMS")
+	}
+
+	transport := &fakeTransport{}
+	transport.set(nil, true)
+	gateway := &MemorySMSGateway{}
+	var records []*CommandRecord
+	var mu sync.Mutex
+	dispatcher := NewDispatcher(transport, &DispatcherConfig{
+		SMS: gateway,
+		SMSDevice: func(imei string) *SMSDevice {
+			if imei == "1" {
+				return &SMSDevice{Imei: imei, Phone: "+370 600 00001", Login: "admin", Password: "secret"}
+			}
+			return nil
+		},
+		OnComplete: func(record *CommandRecord) {
+			mu.Lock()
+			defer mu.Unlock()
+			records = append(records, record)
+		},
+	})
+	gateway.OnSend = func(sms SMS) {
+		// a GPRS response is not matched with the command sent by SMS
+		if dispatcher.HandlePacket("1", response(teltonika.Codec12, teltonika.TypeResponse, "", "x")) ||
+			dispatcher.HandleSMS("37060000002", "x") {
+			t.Error("foreign response matched")
+		}
+		// a device alert and a reply to an earlier command arrive first
+		if dispatcher.HandleSMS("37060000001", "Tow detected") || dispatcher.HandleSMS("37060000001", "Ver:03.28.07 Hw:FMB920") {
+			t.Error("unrelated SMS matched")
+		}
+		gateway.Deliver("37060000001", "GPS:1 Sat:7 Lat:54.684254 Long:25.275888")
+	}
+
+	msg, err := dispatcher.Send(context.Background(), "1", GetGPS())
+	if err != nil || msg.Text != "GPS:1 Sat:7 Lat:54.684254 Long:25.275888" {
+		t.Fatalf("unexpected response %+v (%v)", msg, err)
+	}
+	if sent := gateway.Sent(); len(sent) != 1 || sent[0].Text != "admin secret getgps" || sent[0].Phone != "+370 600 00001" {
+		t.Errorf("unexpected SMS %+v", sent)
+	}
+	// the device without SMS access fails with the transport error
+	if _, err = dispatcher.Send(context.Background(), "2", GetGPS()); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("unexpected error %v", err)
+	}
+	gateway.Err = fmt.Errorf("no credit")
+	// the transport error stays comparable with errors.Is
+	_, err = dispatcher.Send(context.Background(), "1", GetGPS())
+	if err == nil || !strings.Contains(err.Error(), "no credit") || errors.Unwrap(err) == nil ||
+		!strings.Contains(errors.Unwrap(err).Error(), "not found") {
+		t.Errorf("unexpected error %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(records) != 3 || records[0].Channel != "sms" || records[1].Channel != "" {
+		t.Errorf("unexpected records %+v", records)
+	}
+}
+
+func TestHTTPSMSGateway(t *testing.T) {
+	var received []*SMS
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var sms SMS
+		if err := json.NewDecoder(r.Body).Decode(&sms); err != nil || r.Header.Get("Authorization") != "Bearer x" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		received = append(received, &sms)
+	}))
+	defer server.Close()
+
+	gateway := NewHTTPSMSGateway(server.URL)
+	gateway.Header.Set("Authorization", "Bearer x")
+	if err := gateway.SendSMS("37060000001", "  getver"); err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || received[0].Text != "  getver" {
+		t.Errorf("unexpected messages %+v", received)
+	}
+	gateway.Header.Del("Authorization")
+	if err := gateway.SendSMS("37060000001", "  getver"); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	var incoming *SMS
+	gateway.SetReceiver(func(sms *SMS) { incoming = sms })
+	w := httptest.NewRecorder()
+	gateway.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/sms", strings.NewReader("from=37060000001&text=Ver:03.28")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("message without content type accepted, status %d", w.Code)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/sms", strings.NewReader("from=37060000001&text=Ver:03.28"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	gateway.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent || incoming == nil || incoming.Phone != "37060000001" || incoming.Text != "Ver:03.28" {
+		t.Errorf("unexpected incoming message %+v, status %d", incoming, w.Code)
+	}
+
+	// messages without the shared token are rejected
+	gateway.Verify = SMSTokenVerifier("secret")
+	incoming = nil
+	for _, it := range []string{"/sms", "/sms?token=x"} {
+		req = httptest.NewRequest(http.MethodPost, it, strings.NewReader(`{"phone":"37060000001","text":"forged"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w = httptest.NewRecorder()
+		gateway.ServeHTTP(w, req)
+		if w.Code != http.StatusUnauthorized || incoming != nil {
+			t.Errorf("%s: unverified message accepted, status %d", it, w.Code)
+		}
+	}
+	req = httptest.NewRequest(http.MethodPost, "/sms?token=secret", strings.NewReader(`{"phone":"37060000001","text":"ok"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	gateway.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent || incoming == nil || incoming.Text != "ok" {
+		t.Errorf("unexpected incoming message %+v, status %d", incoming, w.Code)
+	}
+}
@@ -0,0 +1,437 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+// DefaultTimeout default time to wait for the device response
+const DefaultTimeout = time.Minute * 3
+
+var (
+	ErrTimeout      = errors.New("device response timeout exceeded")
+	ErrDisconnected = errors.New("device disconnected")
+	ErrQueueFull    = errors.New("device command queue is full")
+)
+
+// Transport delivers packets to connected devices
+type Transport interface {
+	SendPacket(imei string, packet *teltonika.Packet) error
+}
+
+// DispatcherConfig Dispatcher options
+type DispatcherConfig struct {
+	Timeout   time.Duration // response timeout, DefaultTimeout if 0
+	QueueSize int           // max number of commands waiting per device, 0 - unlimited
+	// after a timeout or cancellation the device may still answer the abandoned command. Within this window
+	// the first response received while no command is in flight, or rejected by the response parser
+	// of the in-flight command, is taken for the late answer and dropped
+	LateResponseWindow time.Duration
+	// OnComplete is called once for every queued command when it is answered or failed
+	OnComplete func(record *CommandRecord)
+	// Interlock holds commands until the vehicle state satisfies the rules, the command is not queued before that.
+	// The rules are checked again right before sending, the command fails with InterlockError if the state has changed
+	Interlock *Interlock
+	// SMS sends the command by SMS if the transport fails to deliver it (the device is offline),
+	// the reply is matched by the device phone number
+	SMS SMSGateway
+	// SMSDevice returns phone number and SMS credentials of the device, nil if the device has no SMS access
+	SMSDevice  func(imei string) *SMSDevice
+	SMSTimeout time.Duration // response timeout of commands sent by SMS, DefaultSMSTimeout if 0
+	// Tunnels commands fail with ErrTunnelOpen while a tunnel to the device is open
+	Tunnels *Tunnels
+}
+
+// SendConfig per command options
+type SendConfig struct {
+	Timeout   time.Duration // overrides DispatcherConfig.Timeout
+	Codec14   bool          // send Codec 14 packet addressed to the device imei
+	Requester string        // identity of the command author, recorded in CommandRecord
+	QueuedAt  time.Time     // time the command was queued if it was stored before sending, e.g. in OfflineQueue
+}
+
+// CommandRecord history entry of a dispatched command
+type CommandRecord struct {
+	Id         uint64             `json:"id"`
+	Requester  string             `json:"requester"`
+	Imei       string             `json:"imei"`
+	Codec      teltonika.CodecId  `json:"codec"`
+	Command    string             `json:"command"`
+	QueuedAt   time.Time          `json:"queuedAt"`
+	SentAt     *time.Time         `json:"sentAt,omitempty"`
+	AnsweredAt *time.Time         `json:"answeredAt,omitempty"`
+	Response   string             `json:"response,omitempty"`
+	Error      string             `json:"error,omitempty"`
+	Interlock  *InterlockDecision `json:"interlock,omitempty"` // set if an interlock rule matched the command
+	Channel    string             `json:"channel,omitempty"`   // "sms" if the command was sent by SMS
+}
+
+// Dispatcher sends commands to devices and matches their responses. Commands to a device are
+// executed one by one in FIFO order, Codec 12 and Codec 14 have no request ids, so a response
+// always belongs to the single in-flight command
+type Dispatcher struct {
+	transport Transport
+	config    DispatcherConfig
+	mu        sync.Mutex
+	devices   map[string]*deviceQueue
+}
+
+type deviceQueue struct {
+	queue     []*request
+	running   bool
+	inflight  *request
+	dropUntil time.Time
+}
+
+type request struct {
+	ctx      context.Context
+	imei     string
+	cmd      *Command
+	packet   *teltonika.Packet
+	timeout  time.Duration
+	response chan *teltonika.Message
+	abort    chan error
+	result   chan error
+	message  *teltonika.Message
+	record   *CommandRecord
+	phone    string // device phone number if the command was sent by SMS
+}
+
+// NewDispatcher create new Dispatcher
+func NewDispatcher(transport Transport, config ...*DispatcherConfig) *Dispatcher {
+	cfg := DispatcherConfig{}
+	if len(config) > 0 && config[0] != nil {
+		cfg = *config[0]
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.SMSTimeout <= 0 {
+		cfg.SMSTimeout = DefaultSMSTimeout
+	}
+	dispatcher := &Dispatcher{transport: transport, config: cfg, devices: map[string]*deviceQueue{}}
+	if cfg.SMS != nil {
+		cfg.SMS.SetReceiver(func(sms *SMS) {
+			dispatcher.HandleSMS(sms.Phone, sms.Text)
+		})
+	}
+	return dispatcher
+}
+
+// Send queues the command and waits for the device response. Returns ErrNotExecuted together
+// with the response message if the device answered with TypeNotExecuted
+func (r *Dispatcher) Send(ctx context.Context, imei string, cmd *Command, config ...*SendConfig) (*teltonika.Message, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	cfg := SendConfig{}
+	if len(config) > 0 && config[0] != nil {
+		cfg = *config[0]
+	}
+	req := &request{
+		ctx: ctx, imei: imei, cmd: cmd, packet: cmd.Packet(), timeout: cfg.Timeout,
+		response: make(chan *teltonika.Message, 1), abort: make(chan error, 1), result: make(chan error, 1),
+	}
+	if cfg.Codec14 {
+		req.packet = cmd.Codec14Packet(imei)
+	}
+	if req.timeout <= 0 {
+		req.timeout = r.config.Timeout
+	}
+	req.record = &CommandRecord{
+		Requester: cfg.Requester, Imei: imei, Codec: req.packet.CodecID, Command: cmd.String(), QueuedAt: cfg.QueuedAt,
+	}
+	if req.record.QueuedAt.IsZero() {
+		req.record.QueuedAt = time.Now()
+	}
+
+	if r.config.Tunnels != nil && r.config.Tunnels.IsOpen(imei) {
+		r.complete(req, ErrTunnelOpen)
+		return nil, ErrTunnelOpen
+	}
+	if r.config.Interlock != nil {
+		decision, err := r.config.Interlock.Await(ctx, imei, cmd)
+		if decision.Rule != "" {
+			req.record.Interlock = decision
+		}
+		if err != nil {
+			r.complete(req, err)
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	dev, ok := r.devices[imei]
+	if !ok {
+		dev = &deviceQueue{}
+		r.devices[imei] = dev
+	}
+	if r.config.QueueSize > 0 && len(dev.queue) >= r.config.QueueSize {
+		r.mu.Unlock()
+		r.complete(req, ErrQueueFull)
+		return nil, ErrQueueFull
+	}
+	dev.queue = append(dev.queue, req)
+	if !dev.running {
+		dev.running = true
+		go r.run(imei, dev)
+	}
+	r.mu.Unlock()
+
+	select {
+	case err := <-req.result:
+		return req.message, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Pending returns number of queued and in-flight commands for the device
+func (r *Dispatcher) Pending(imei string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dev, ok := r.devices[imei]
+	if !ok {
+		return 0
+	}
+	n := len(dev.queue)
+	if dev.inflight != nil {
+		n++
+	}
+	return n
+}
+
+// HandlePacket matches Codec 12/14 responses of the packet with the in-flight command of the device,
+// returns false if the packet has no responses or they do not belong to any command
+func (r *Dispatcher) HandlePacket(imei string, packet *teltonika.Packet) bool {
+	if packet.CodecID != teltonika.Codec12 && packet.CodecID != teltonika.Codec14 {
+		return false
+	}
+	matched := false
+	for i := range packet.Messages {
+		message := &packet.Messages[i]
+		if message.Type != teltonika.TypeResponse && message.Type != teltonika.TypeNotExecuted {
+			continue
+		}
+		r.mu.Lock()
+		dev, ok := r.devices[imei]
+		if !ok {
+			r.mu.Unlock()
+			continue
+		}
+		late := time.Now().Before(dev.dropUntil)
+		req := dev.inflight
+		if req == nil {
+			if late {
+				// the late answer to the abandoned command, responses after it are matched as usual
+				dev.dropUntil = time.Time{}
+			}
+			r.mu.Unlock()
+			continue
+		}
+		if !matchResponse(req, packet.CodecID, message) {
+			r.mu.Unlock()
+			continue
+		}
+		if late && !mayBelong(req, message) {
+			dev.dropUntil = time.Time{}
+			r.mu.Unlock()
+			continue
+		}
+		dev.inflight = nil
+		r.mu.Unlock()
+		req.response <- message
+		matched = true
+	}
+	return matched
+}
+
+// HandleSMS matches the SMS reply with the in-flight command sent by SMS to the phone number,
+// returns false if no command waits for the reply. Messages rejected by the response parser of the command
+// (device alerts, replies to timed out commands) are not matched
+func (r *Dispatcher) HandleSMS(phone string, text string) bool {
+	message := &teltonika.Message{Type: teltonika.TypeResponse, Text: text}
+	r.mu.Lock()
+	for _, dev := range r.devices {
+		req := dev.inflight
+		if req == nil || req.phone == "" || !samePhone(req.phone, phone) || !mayBelong(req, message) {
+			continue
+		}
+		dev.inflight = nil
+		r.mu.Unlock()
+		req.response <- message
+		return true
+	}
+	r.mu.Unlock()
+	return false
+}
+
+// Disconnected fails the in-flight command of the device with ErrDisconnected,
+// commands sent by SMS keep waiting for the reply
+func (r *Dispatcher) Disconnected(imei string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if dev, ok := r.devices[imei]; ok && dev.inflight != nil && dev.inflight.phone == "" {
+		dev.inflight.abort <- ErrDisconnected
+		dev.inflight = nil
+	}
+}
+
+func (r *Dispatcher) run(imei string, dev *deviceQueue) {
+	for {
+		r.mu.Lock()
+		if len(dev.queue) == 0 {
+			dev.running = false
+			if dev.inflight == nil && time.Now().After(dev.dropUntil) {
+				delete(r.devices, imei)
+			}
+			r.mu.Unlock()
+			return
+		}
+		req := dev.queue[0]
+		dev.queue[0] = nil
+		dev.queue = dev.queue[1:]
+		if err := req.ctx.Err(); err != nil {
+			r.mu.Unlock()
+			r.complete(req, err)
+			req.result <- err
+			continue
+		}
+		dev.inflight = req
+		r.mu.Unlock()
+
+		err := r.execute(req)
+
+		r.mu.Lock()
+		if dev.inflight == req {
+			dev.inflight = nil
+			if err != nil && req.record.SentAt != nil && r.config.LateResponseWindow > 0 {
+				dev.dropUntil = time.Now().Add(r.config.LateResponseWindow)
+			}
+		}
+		r.mu.Unlock()
+		r.complete(req, err)
+		req.result <- err
+	}
+}
+
+func (r *Dispatcher) complete(req *request, err error) {
+	if r.config.OnComplete == nil {
+		return
+	}
+	if req.message != nil {
+		req.record.Response = req.cmd.ResponseString(req.message)
+	}
+	if err != nil {
+		req.record.Error = err.Error()
+	}
+	r.config.OnComplete(req.record)
+}
+
+func (r *Dispatcher) execute(req *request) error {
+	if r.config.Tunnels != nil && r.config.Tunnels.IsOpen(req.imei) {
+		return ErrTunnelOpen
+	}
+	if r.config.Interlock != nil {
+		// the state may have changed while the command was waiting in the queue
+		if decision := r.config.Interlock.Check(req.imei, req.cmd); !decision.Allowed {
+			req.record.Interlock = decision
+			return &InterlockError{Decision: decision}
+		}
+	}
+	timeout := req.timeout
+	if err := r.transport.SendPacket(req.imei, req.packet); err != nil {
+		if smsErr := r.sendSMS(req); smsErr != nil {
+			if smsErr == errNoSMS {
+				return err
+			}
+			return fmt.Errorf("%w, SMS fallback failed (%v)", err, smsErr)
+		}
+		timeout = r.config.SMSTimeout
+	}
+	sentAt := time.Now()
+	req.record.SentAt = &sentAt
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case message := <-req.response:
+		answeredAt := time.Now()
+		req.record.AnsweredAt = &answeredAt
+		req.message = message
+		if message.Type == teltonika.TypeNotExecuted {
+			return ErrNotExecuted
+		}
+		return nil
+	case err := <-req.abort:
+		return err
+	case <-timer.C:
+		return ErrTimeout
+	case <-req.ctx.Done():
+		return req.ctx.Err()
+	}
+}
+
+var errNoSMS = errors.New("SMS is not available for the device")
+
+// sendSMS sends the command to the device phone number, the in-flight command then waits for the SMS reply
+func (r *Dispatcher) sendSMS(req *request) error {
+	if r.config.SMS == nil || r.config.SMSDevice == nil {
+		return errNoSMS
+	}
+	device := r.config.SMSDevice(req.imei)
+	if device == nil || device.Phone == "" {
+		return errNoSMS
+	}
+	text, err := FormatSMS(device, req.cmd)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	req.phone = device.Phone
+	r.mu.Unlock()
+	req.record.Channel = "sms"
+	if err = r.config.SMS.SendSMS(device.Phone, text); err != nil {
+		r.mu.Lock()
+		req.phone = ""
+		r.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// mayBelong reports whether the response may be the answer to the request,
+// a response rejected by the parser of the command can not
+func mayBelong(req *request, message *teltonika.Message) bool {
+	if message.Type != teltonika.TypeResponse {
+		return true
+	}
+	_, err := req.cmd.ParseResponse(message.Text)
+	return err == nil
+}
+
+func matchResponse(req *request, codec teltonika.CodecId, message *teltonika.Message) bool {
+	if req.phone != "" {
+		// the command was sent by SMS, its reply arrives through HandleSMS
+		return false
+	}
+	if req.packet.CodecID != codec {
+		return false
+	}
+	if codec == teltonika.Codec14 && message.Type == teltonika.TypeResponse {
+		return sameImei(message.Imei, req.imei)
+	}
+	return true
+}
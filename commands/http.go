@@ -0,0 +1,94 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+// MaxCommandSize limit of the command request body read by ReadCommand
+const MaxCommandSize = 64 * 1024
+
+// ReadCommand reads the command from the request body, "format" query param: text (default), hex, base64
+// or binary (raw body), the command is sent as raw bytes if the format is not text
+func ReadCommand(r *http.Request) (*Command, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxCommandSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("request body read error (%v)", err)
+	}
+	if len(body) > MaxCommandSize {
+		return nil, fmt.Errorf("command exceeds %d bytes", MaxCommandSize)
+	}
+	var payload []byte
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "text":
+		return ParseCommand(string(body))
+	case "hex":
+		payload, err = hex.DecodeString(strings.TrimSpace(string(body)))
+	case "base64":
+		payload, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(body)))
+	case "binary":
+		payload = body
+	default:
+		return nil, fmt.Errorf("unknown format '%s', expected text, hex, base64 or binary", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload (%v)", err)
+	}
+	cmd := Binary(payload)
+	return cmd, cmd.Validate()
+}
+
+// CommandResult builds the command response body, the response to a binary command and the response
+// which is not valid UTF-8 text are returned as "payload" in hex (base64 if the command format is base64)
+func CommandResult(cmd *Command, msg *teltonika.Message, format string) map[string]interface{} {
+	res := map[string]interface{}{}
+	if cmd.Payload != nil || !utf8.ValidString(msg.Text) {
+		if format == "base64" {
+			res["payload"] = base64.StdEncoding.EncodeToString(msg.Bytes())
+		} else {
+			res["payload"] = hex.EncodeToString(msg.Bytes())
+		}
+	}
+	if cmd.Payload == nil {
+		res["response"] = msg.Text
+	}
+	if text, err := ResponseText(msg); err != nil {
+		res["error"] = err.Error()
+	} else if parsed, err := cmd.ParseResponse(text); err != nil {
+		res["parseError"] = err.Error()
+	} else if parsed != nil {
+		res["parsed"] = parsed
+	}
+	return res
+}
+
+// Requester returns identity of the request author: basic auth user name if the password matches
+// users (user name -> password), otherwise remote address. Names that can't be verified (basic auth
+// user name with a wrong password or unknown, X-Requester header) are returned as "name (unverified, address)"
+func Requester(r *http.Request, users map[string]string) string {
+	claimed := r.Header.Get("X-Requester")
+	if user, password, ok := r.BasicAuth(); ok && user != "" {
+		if expected, ok := users[user]; ok && subtle.ConstantTimeCompare([]byte(password), []byte(expected)) == 1 {
+			return user
+		}
+		claimed = user
+	}
+	if claimed != "" {
+		return fmt.Sprintf("%s (unverified, %s)", claimed, r.RemoteAddr)
+	}
+	return r.RemoteAddr
+}
@@ -0,0 +1,295 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika"
+	"github.com/alim-zanibekov/teltonika/ioelements"
+)
+
+// VehicleState latest known state of the vehicle taken from a decoded AVL record
+type VehicleState struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Speed      uint16    `json:"speed"`
+	Satellites uint8     `json:"satellites"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	Ignition   *bool     `json:"ignition,omitempty"` // nil if the record has no Ignition (239) I/O element
+}
+
+// InterlockBinary command name matching binary commands in InterlockRule.Commands. Binary commands can not be
+// matched by name, they are blocked while any rule is configured unless a rule lists InterlockBinary
+const InterlockBinary = "binary"
+
+// DefaultInterlockStateAge states older than that are not trusted by rules without MaxStateAge
+const DefaultInterlockStateAge = time.Minute * 5
+
+// InterlockRule conditions required before commands with the listed names are released
+type InterlockRule struct {
+	Name          string        `json:"name"`
+	Commands      []string      `json:"commands"`                // command names, e.g. "setdigout", or InterlockBinary
+	MaxSpeed      *uint16       `json:"maxSpeed,omitempty"`      // speed must be <= MaxSpeed km/h, requires a GNSS fix
+	IgnitionOff   bool          `json:"ignitionOff,omitempty"`   // ignition must be off
+	RequireFix    bool          `json:"requireFix,omitempty"`    // GNSS fix must be present
+	MinSatellites uint8         `json:"minSatellites,omitempty"` // satellites for the fix, 3 if 0
+	MaxStateAge   time.Duration `json:"maxStateAge,omitempty"`   // the state older than that is not trusted, DefaultInterlockStateAge if 0, < 0 - any age
+	Wait          time.Duration `json:"wait,omitempty"`          // how long the command waits for the conditions
+}
+
+// MarshalJSON encodes durations as strings, e.g. "10m"
+func (r InterlockRule) MarshalJSON() ([]byte, error) {
+	type rule InterlockRule
+	return json.Marshal(struct {
+		rule
+		MaxStateAge string `json:"maxStateAge,omitempty"`
+		Wait        string `json:"wait,omitempty"`
+	}{rule(r), formatDuration(r.MaxStateAge), formatDuration(r.Wait)})
+}
+
+// UnmarshalJSON decodes durations from strings ("10m", "30s")
+func (r *InterlockRule) UnmarshalJSON(data []byte) error {
+	type rule InterlockRule
+	value := struct {
+		*rule
+		MaxStateAge string `json:"maxStateAge"`
+		Wait        string `json:"wait"`
+	}{rule: (*rule)(r)}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	var err error
+	if value.MaxStateAge != "" {
+		if r.MaxStateAge, err = time.ParseDuration(value.MaxStateAge); err != nil {
+			return fmt.Errorf("interlock rule '%s': invalid maxStateAge (%v)", r.Name, err)
+		}
+	}
+	if value.Wait != "" {
+		if r.Wait, err = time.ParseDuration(value.Wait); err != nil {
+			return fmt.Errorf("interlock rule '%s': invalid wait (%v)", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+// InterlockDecision result of checking the command against the rules
+type InterlockDecision struct {
+	Allowed bool          `json:"allowed"`
+	Rule    string        `json:"rule,omitempty"`
+	Reasons []string      `json:"reasons,omitempty"` // unmet conditions
+	State   *VehicleState `json:"state,omitempty"`
+	Waited  time.Duration `json:"waited,omitempty"`
+}
+
+// MarshalJSON encodes Waited as a string, e.g. "10m0s"
+func (r InterlockDecision) MarshalJSON() ([]byte, error) {
+	type decision InterlockDecision
+	return json.Marshal(struct {
+		decision
+		Waited string `json:"waited,omitempty"`
+	}{decision(r), formatDuration(r.Waited)})
+}
+
+// InterlockError returned when the command is held by the interlock until the deadline
+type InterlockError struct {
+	Decision *InterlockDecision
+}
+
+func (r *InterlockError) Error() string {
+	return fmt.Sprintf("command blocked by interlock '%s': %s", r.Decision.Rule, strings.Join(r.Decision.Reasons, ", "))
+}
+
+// Interlock holds commands until the vehicle state satisfies the rules
+type Interlock struct {
+	mu      sync.Mutex
+	rules   []InterlockRule
+	states  map[string]*VehicleState
+	changed chan struct{} // closed and replaced on every state update
+}
+
+// NewInterlock create new Interlock
+func NewInterlock(rules []InterlockRule) *Interlock {
+	return &Interlock{rules: rules, states: map[string]*VehicleState{}, changed: make(chan struct{})}
+}
+
+// SetRules replaces the rules, waiting commands are checked against the new rules on the next state update
+func (r *Interlock) SetRules(rules []InterlockRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = rules
+}
+
+// Rules returns the current rules
+func (r *Interlock) Rules() []InterlockRule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]InterlockRule{}, r.rules...)
+}
+
+// StateFromData extracts the vehicle state from the AVL record
+func StateFromData(data *teltonika.Data) *VehicleState {
+	state := &VehicleState{
+		Timestamp: time.UnixMilli(int64(data.TimestampMs)), Speed: data.Speed, Satellites: data.Satellites,
+		Lat: data.Lat, Lng: data.Lng,
+	}
+	for _, it := range data.Elements {
+		if it.Id == ioelements.IgnitionID && len(it.Value) > 0 {
+			ignition := it.Value[len(it.Value)-1] != 0
+			state.Ignition = &ignition
+		}
+	}
+	return state
+}
+
+// Update stores the state of the latest record of the packet
+func (r *Interlock) Update(imei string, packet *teltonika.Packet) {
+	var latest *teltonika.Data
+	for i := range packet.Data {
+		if latest == nil || packet.Data[i].TimestampMs >= latest.TimestampMs {
+			latest = &packet.Data[i]
+		}
+	}
+	if latest != nil {
+		r.SetState(imei, StateFromData(latest))
+	}
+}
+
+// SetState stores the vehicle state unless a newer one is known
+func (r *Interlock) SetState(imei string, state *VehicleState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if current, ok := r.states[imei]; ok && current.Timestamp.After(state.Timestamp) {
+		return
+	}
+	r.states[imei] = state
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// State returns the latest known vehicle state
+func (r *Interlock) State(imei string) (*VehicleState, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.states[imei]
+	return state, ok
+}
+
+// Check evaluates the rule matching the command with the current state, commands without rules are allowed
+func (r *Interlock) Check(imei string, cmd *Command) *InterlockDecision {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	decision, _ := r.check(imei, cmd)
+	return decision
+}
+
+// Await waits until the rule matching the command is satisfied, returns InterlockError
+// if the rule wait time is exceeded
+func (r *Interlock) Await(ctx context.Context, imei string, cmd *Command) (*InterlockDecision, error) {
+	started := time.Now()
+	for {
+		r.mu.Lock()
+		decision, rule := r.check(imei, cmd)
+		changed := r.changed
+		r.mu.Unlock()
+
+		decision.Waited = time.Since(started)
+		if decision.Allowed {
+			return decision, nil
+		}
+		remaining := rule.Wait - decision.Waited
+		if remaining <= 0 {
+			return decision, &InterlockError{Decision: decision}
+		}
+		// the decision can change only with a new state, aging makes it only worse
+		timer := time.NewTimer(remaining)
+		select {
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return decision, ctx.Err()
+		}
+	}
+}
+
+func (r *Interlock) check(imei string, cmd *Command) (*InterlockDecision, *InterlockRule) {
+	cmdName := cmd.Name
+	if cmd.Payload != nil {
+		cmdName = InterlockBinary
+	}
+	var rule *InterlockRule
+	for i := range r.rules {
+		for _, name := range r.rules[i].Commands {
+			if strings.EqualFold(name, cmdName) {
+				rule = &r.rules[i]
+				break
+			}
+		}
+		if rule != nil {
+			break
+		}
+	}
+	if rule == nil && cmd.Payload != nil && len(r.rules) > 0 {
+		rule = &InterlockRule{Name: InterlockBinary}
+		return &InterlockDecision{Rule: rule.Name, Reasons: []string{"binary commands are not allowed by the interlock rules"}}, rule
+	}
+	if rule == nil {
+		return &InterlockDecision{Allowed: true}, nil
+	}
+
+	decision := &InterlockDecision{Rule: rule.Name}
+	state, ok := r.states[imei]
+	if !ok {
+		decision.Reasons = []string{"vehicle state is unknown"}
+		return decision, rule
+	}
+	stateCopy := *state
+	decision.State = &stateCopy
+
+	maxStateAge := rule.MaxStateAge
+	if maxStateAge == 0 {
+		maxStateAge = DefaultInterlockStateAge
+	}
+	if maxStateAge > 0 && time.Since(state.Timestamp) > maxStateAge {
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("vehicle state is older than %v", maxStateAge))
+	}
+	// the speed is reported as 0 without a fix, so it is not trusted either
+	minSatellites := rule.MinSatellites
+	if minSatellites == 0 {
+		minSatellites = 3
+	}
+	hasFix := state.Satellites >= minSatellites && (state.Lat != 0 || state.Lng != 0)
+	if (rule.RequireFix || rule.MaxSpeed != nil) && !hasFix {
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("no GNSS fix (%d satellites)", state.Satellites))
+	}
+	if rule.MaxSpeed != nil && hasFix && state.Speed > *rule.MaxSpeed {
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("speed %d km/h exceeds %d km/h", state.Speed, *rule.MaxSpeed))
+	}
+	if rule.IgnitionOff && (state.Ignition == nil || *state.Ignition) {
+		if state.Ignition == nil {
+			decision.Reasons = append(decision.Reasons, "ignition state is unknown")
+		} else {
+			decision.Reasons = append(decision.Reasons, "ignition is on")
+		}
+	}
+	decision.Allowed = len(decision.Reasons) == 0
+	return decision, rule
+}
@@ -0,0 +1,266 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika/params"
+)
+
+// DefaultMaxCommandLength command text length limit used when batching parameters
+const DefaultMaxCommandLength = 512
+
+// Executor sends the command to the device and returns the response text
+type Executor func(cmd *Command) (string, error)
+
+// Profile named set of configuration parameter values
+type Profile struct {
+	Name   string       `json:"name"`
+	Params []ParamValue `json:"params"`
+}
+
+// ApplyConfig ApplyProfile options
+type ApplyConfig struct {
+	MaxCommandLength int // command text length limit, DefaultMaxCommandLength if 0
+	Retries          int // number of additional attempts for parameters that did not match after read-back
+}
+
+// ParamCompliance read-back result of a single parameter
+type ParamCompliance struct {
+	Id        uint32 `json:"id"`
+	Expected  string `json:"expected"`
+	Actual    string `json:"actual"`
+	Compliant bool   `json:"compliant"`
+	Attempts  int    `json:"attempts"`
+}
+
+// ComplianceReport result of applying the profile to a device
+type ComplianceReport struct {
+	Profile   string            `json:"profile"`
+	Started   time.Time         `json:"started"`
+	Finished  time.Time         `json:"finished"`
+	Attempts  int               `json:"attempts"`
+	Compliant bool              `json:"compliant"`
+	Params    []ParamCompliance `json:"params"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// NewProfile creates a profile from parameter values (e.g. parsed .cfg file), parameters are sorted by id
+func NewProfile(name string, values params.Values) *Profile {
+	profile := &Profile{Name: name, Params: make([]ParamValue, 0, len(values))}
+	for _, id := range values.Ids() {
+		profile.Params = append(profile.Params, ParamValue{Id: id, Value: values[id]})
+	}
+	return profile
+}
+
+// Values returns profile parameter values, the last value wins if a parameter is listed more than once
+func (r *Profile) Values() params.Values {
+	res := params.Values{}
+	for _, it := range r.Params {
+		res[it.Id] = it.Value
+	}
+	return res
+}
+
+// ReadParams reads current parameter values from the device with getparam commands
+func ReadParams(exec Executor, ids []uint32, maxLength int) (params.Values, error) {
+	cmds, err := BatchGetParam(ids, maxLength)
+	if err != nil {
+		return nil, err
+	}
+	res := params.Values{}
+	for _, cmd := range cmds {
+		text, err := exec(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", cmd.Name, err)
+		}
+		values, err := ParseParams(text)
+		if err != nil {
+			return nil, err
+		}
+		for id, value := range values {
+			res[id] = value
+		}
+	}
+	return res, nil
+}
+
+// Validate checks parameter values syntax and, if catalogue is not nil, value types and ranges for the model
+func (r *Profile) Validate(catalogue *params.Catalogue, model string) error {
+	if len(r.Params) == 0 {
+		return fmt.Errorf("profile '%s' has no parameters", r.Name)
+	}
+	for _, it := range r.Params {
+		if strings.ContainsAny(it.Value, ";\r\n") {
+			return fmt.Errorf("parameter %d: value '%s' contains forbidden characters", it.Id, it.Value)
+		}
+		if catalogue == nil {
+			continue
+		}
+		def, err := catalogue.GetParam(model, it.Id)
+		if err != nil {
+			return err
+		}
+		if err = def.Validate(it.Value); err != nil {
+			return fmt.Errorf("parameter %d (%s): %v", it.Id, def.Name, err)
+		}
+	}
+	_, err := BatchSetParam(r.Params, 0)
+	return err
+}
+
+// BatchSetParam splits values into setparam commands with text no longer than maxLength,
+// DefaultMaxCommandLength is used if maxLength is 0
+func BatchSetParam(values []ParamValue, maxLength int) ([]*Command, error) {
+	pairs := make([]string, 0, len(values))
+	for _, it := range values {
+		pairs = append(pairs, fmt.Sprintf("%d:%s", it.Id, it.Value))
+	}
+	return batch("setparam", pairs, maxLength)
+}
+
+// BatchGetParam splits ids into getparam commands with text no longer than maxLength,
+// DefaultMaxCommandLength is used if maxLength is 0
+func BatchGetParam(ids []uint32, maxLength int) ([]*Command, error) {
+	list := make([]string, 0, len(ids))
+	for _, it := range ids {
+		list = append(list, strconv.FormatUint(uint64(it), 10))
+	}
+	return batch("getparam", list, maxLength)
+}
+
+func batch(name string, items []string, maxLength int) ([]*Command, error) {
+	if maxLength <= 0 {
+		maxLength = DefaultMaxCommandLength
+	}
+	var res []*Command
+	var current []string
+	size := len(name) + 1
+	flush := func() error {
+		if len(current) == 0 {
+			return nil
+		}
+		cmd := New(name, strings.Join(current, ";"))
+		if err := cmd.Validate(); err != nil {
+			return err
+		}
+		res = append(res, cmd)
+		current, size = nil, len(name)+1
+		return nil
+	}
+	for _, it := range items {
+		if len(name)+1+len(it) > maxLength {
+			return nil, fmt.Errorf("%s '%s' does not fit into %d bytes command", name, it, maxLength)
+		}
+		sep := 0
+		if len(current) > 0 {
+			sep = 1
+		}
+		if size+sep+len(it) > maxLength {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			sep = 0
+		}
+		current = append(current, it)
+		size += sep + len(it)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ApplyProfile sends profile parameters with setparam commands, reads them back with getparam
+// and resends mismatched ones up to config.Retries times. An error is returned only if the
+// executor fails, the report then contains the state known at that moment
+func ApplyProfile(exec Executor, profile *Profile, config ...*ApplyConfig) (*ComplianceReport, error) {
+	cfg := &ApplyConfig{}
+	if len(config) > 0 && config[0] != nil {
+		cfg = config[0]
+	}
+
+	report := &ComplianceReport{Profile: profile.Name, Started: time.Now()}
+	// the last value wins if a parameter is listed more than once
+	index := map[uint32]int{}
+	for _, it := range profile.Params {
+		if i, ok := index[it.Id]; ok {
+			report.Params[i].Expected = it.Value
+			continue
+		}
+		index[it.Id] = len(report.Params)
+		report.Params = append(report.Params, ParamCompliance{Id: it.Id, Expected: it.Value})
+	}
+	expected := map[uint32]*ParamCompliance{}
+	pending := make([]ParamValue, 0, len(report.Params))
+	for i, it := range report.Params {
+		expected[it.Id] = &report.Params[i]
+		pending = append(pending, ParamValue{Id: it.Id, Value: it.Expected})
+	}
+
+	finish := func(err error) (*ComplianceReport, error) {
+		report.Finished = time.Now()
+		report.Compliant = err == nil
+		for _, it := range report.Params {
+			report.Compliant = report.Compliant && it.Compliant
+		}
+		if err != nil {
+			report.Error = err.Error()
+		}
+		return report, err
+	}
+
+	for attempt := 0; attempt <= cfg.Retries && len(pending) > 0; attempt++ {
+		report.Attempts++
+		setCommands, err := BatchSetParam(pending, cfg.MaxCommandLength)
+		if err != nil {
+			return finish(err)
+		}
+		ids := make([]uint32, 0, len(pending))
+		for _, it := range pending {
+			expected[it.Id].Attempts++
+			ids = append(ids, it.Id)
+		}
+		for _, cmd := range setCommands {
+			if _, err = exec(cmd); err != nil {
+				return finish(fmt.Errorf("%s: %v", cmd.Name, err))
+			}
+		}
+
+		getCommands, err := BatchGetParam(ids, cfg.MaxCommandLength)
+		if err != nil {
+			return finish(err)
+		}
+		for _, cmd := range getCommands {
+			text, err := exec(cmd)
+			if err != nil {
+				return finish(fmt.Errorf("%s: %v", cmd.Name, err))
+			}
+			// unparsable response leaves the parameters as not compliant
+			values, _ := ParseParams(text)
+			for id, value := range values {
+				if p, ok := expected[id]; ok {
+					p.Actual = value
+					p.Compliant = value == p.Expected
+				}
+			}
+		}
+
+		pending = pending[:0:0]
+		for _, it := range report.Params {
+			if !it.Compliant {
+				pending = append(pending, ParamValue{Id: it.Id, Value: it.Expected})
+			}
+		}
+	}
+	return finish(nil)
+}
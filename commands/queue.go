@@ -0,0 +1,455 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// QueueStatus state of a queued command
+type QueueStatus string
+
+const (
+	StatusQueued   QueueStatus = "queued"   // waiting for the device to connect
+	StatusSent     QueueStatus = "sent"     // sent, waiting for the response
+	StatusAnswered QueueStatus = "answered" // the device responded
+	StatusExpired  QueueStatus = "expired"  // not delivered before ExpiresAt
+	StatusFailed   QueueStatus = "failed"   // MaxAttempts reached without a response
+)
+
+// QueuedCommand command waiting for delivery to an offline device
+type QueuedCommand struct {
+	Id          uint64      `json:"id"`
+	Imei        string      `json:"imei"`
+	Command     string      `json:"command"`
+	Codec14     bool        `json:"codec14,omitempty"`
+	Requester   string      `json:"requester,omitempty"`
+	Job         string      `json:"job,omitempty"` // name of the scheduler job that created the command
+	Priority    int         `json:"priority"`
+	Status      QueueStatus `json:"status"`
+	Attempts    int         `json:"attempts"`
+	MaxAttempts int         `json:"maxAttempts"`
+	CreatedAt   time.Time   `json:"createdAt"`
+	UpdatedAt   time.Time   `json:"updatedAt"`
+	ExpiresAt   time.Time   `json:"expiresAt"`
+	Response    string      `json:"response,omitempty"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// QueueConfig OfflineQueue options
+type QueueConfig struct {
+	TTL         time.Duration // default command lifetime, 24 hours if 0
+	MaxAttempts int           // default delivery attempts limit, 3 if 0
+	Timeout     time.Duration // response timeout of a delivery attempt, dispatcher default if 0
+	Retention   time.Duration // how long finished commands are kept, 7 days if 0
+	// OnFinish is called when the command is answered, expired or failed,
+	// it is called with the queue lock held and must not call OfflineQueue methods
+	OnFinish func(it QueuedCommand)
+}
+
+// EnqueueOptions per command options, zero values are replaced with QueueConfig defaults
+type EnqueueOptions struct {
+	Priority    int // commands with higher priority are delivered first
+	TTL         time.Duration
+	MaxAttempts int
+	Codec14     bool
+	Requester   string
+	Job         string
+}
+
+// OfflineQueue durable queue of commands delivered when the device connects.
+// Commands of a device are delivered by priority (higher first), then in order of creation
+type OfflineQueue struct {
+	mu         sync.Mutex
+	store      *fileStore
+	items      map[uint64]*QueuedCommand
+	nextId     uint64
+	delivering map[string]bool
+	dispatcher *Dispatcher
+	config     QueueConfig
+}
+
+// OpenOfflineQueue opens (or creates) the queue stored in the file at path
+func OpenOfflineQueue(path string, dispatcher *Dispatcher, config ...*QueueConfig) (*OfflineQueue, error) {
+	cfg := QueueConfig{}
+	if len(config) > 0 && config[0] != nil {
+		cfg = *config[0]
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = time.Hour * 24
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = time.Hour * 24 * 7
+	}
+
+	store, items, err := openFileStore(path)
+	if err != nil {
+		return nil, err
+	}
+	q := &OfflineQueue{
+		store: store, items: items, dispatcher: dispatcher, config: cfg, delivering: map[string]bool{},
+	}
+	for id, it := range items {
+		if id >= q.nextId {
+			q.nextId = id + 1
+		}
+		// the process stopped while waiting for the response, deliver again
+		if it.Status == StatusSent {
+			it.Status = StatusQueued
+		}
+	}
+	if q.nextId == 0 {
+		q.nextId = 1
+	}
+	if err = q.compact(); err != nil {
+		_ = store.close()
+		return nil, err
+	}
+	return q, nil
+}
+
+// Enqueue stores the command for delivery
+func (r *OfflineQueue) Enqueue(imei string, cmd *Command, options ...*EnqueueOptions) (*QueuedCommand, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	opts := EnqueueOptions{}
+	if len(options) > 0 && options[0] != nil {
+		opts = *options[0]
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = r.config.TTL
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = r.config.MaxAttempts
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	it := &QueuedCommand{
+		Id: r.nextId, Imei: imei, Command: cmd.String(), Codec14: opts.Codec14, Requester: opts.Requester, Job: opts.Job,
+		Priority: opts.Priority, Status: StatusQueued, MaxAttempts: opts.MaxAttempts,
+		CreatedAt: now, UpdatedAt: now, ExpiresAt: now.Add(opts.TTL),
+	}
+	if err := r.store.put(it); err != nil {
+		return nil, err
+	}
+	r.nextId++
+	r.items[it.Id] = it
+	res := *it
+	return &res, nil
+}
+
+// Get returns a copy of the queued command
+func (r *OfflineQueue) Get(id uint64) (*QueuedCommand, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	it, ok := r.items[id]
+	if !ok {
+		return nil, false
+	}
+	res := *it
+	return &res, true
+}
+
+// List returns copies of commands of the device (all devices if imei is empty) sorted by id
+func (r *OfflineQueue) List(imei string) []*QueuedCommand {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]*QueuedCommand, 0)
+	for _, it := range r.items {
+		if imei == "" || it.Imei == imei {
+			c := *it
+			res = append(res, &c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Id < res[j].Id })
+	return res
+}
+
+// Deliver sends queued commands of the device, call it when the device connects.
+// Delivery stops if the device disconnects, remaining commands wait for the next connection
+func (r *OfflineQueue) Deliver(ctx context.Context, imei string) error {
+	r.mu.Lock()
+	if r.delivering[imei] {
+		r.mu.Unlock()
+		return nil
+	}
+	r.delivering[imei] = true
+	r.mu.Unlock()
+	done := false
+	defer func() {
+		if !done {
+			r.mu.Lock()
+			delete(r.delivering, imei)
+			r.mu.Unlock()
+		}
+	}()
+
+	for {
+		it, err := r.next(imei)
+		if err != nil {
+			return err
+		}
+		if it == nil {
+			// next released the device, commands queued after that start a new delivery
+			done = true
+			return nil
+		}
+		cmd, err := ParseCommand(it.Command)
+		if err != nil {
+			if err = r.finish(it, StatusFailed, "", err); err != nil {
+				return err
+			}
+			continue
+		}
+		msg, err := r.dispatcher.Send(ctx, imei, cmd, &SendConfig{
+			Timeout: r.config.Timeout, Codec14: it.Codec14, Requester: it.Requester, QueuedAt: it.CreatedAt,
+		})
+		switch {
+		case err == nil || errors.Is(err, ErrNotExecuted):
+			if err = r.finish(it, StatusAnswered, cmd.ResponseString(msg), err); err != nil {
+				return err
+			}
+		case it.Attempts >= it.MaxAttempts || errors.As(err, new(*InterlockError)):
+			if err = r.finish(it, StatusFailed, "", err); err != nil {
+				return err
+			}
+		default:
+			if serr := r.finish(it, StatusQueued, "", err); serr != nil {
+				return serr
+			}
+			// timeout is retried on the next connection too, the device may be unable to answer now
+			return err
+		}
+	}
+}
+
+// Expire marks commands that were not delivered in time as expired and drops finished commands
+// older than the retention period
+func (r *OfflineQueue) Expire() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, it := range r.items {
+		if it.Status == StatusQueued && now.After(it.ExpiresAt) {
+			if err := r.update(it, StatusExpired, "", nil); err != nil {
+				return err
+			}
+		}
+	}
+	return r.compact()
+}
+
+// Close closes the underlying file
+func (r *OfflineQueue) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.store.close()
+}
+
+// next marks the next command of the device as sent, expired commands are skipped.
+// If there are no commands the device delivery flag is cleared under the same lock
+func (r *OfflineQueue) next(imei string) (*QueuedCommand, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var candidates []*QueuedCommand
+	now := time.Now()
+	for _, it := range r.items {
+		if it.Imei != imei || it.Status != StatusQueued {
+			continue
+		}
+		if now.After(it.ExpiresAt) {
+			if err := r.update(it, StatusExpired, "", nil); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		candidates = append(candidates, it)
+	}
+	if len(candidates) == 0 {
+		delete(r.delivering, imei)
+		return nil, nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority > candidates[j].Priority
+		}
+		return candidates[i].Id < candidates[j].Id
+	})
+	it := candidates[0]
+	it.Attempts++
+	if err := r.update(it, StatusSent, "", nil); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+func (r *OfflineQueue) finish(it *QueuedCommand, status QueueStatus, response string, err error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.update(it, status, response, err)
+}
+
+func (r *OfflineQueue) update(it *QueuedCommand, status QueueStatus, response string, err error) error {
+	it.Status = status
+	it.UpdatedAt = time.Now()
+	it.Response = response
+	it.Error = ""
+	if err != nil {
+		it.Error = err.Error()
+	}
+	if err = r.store.put(it); err != nil {
+		return err
+	}
+	if r.config.OnFinish != nil && (status == StatusAnswered || status == StatusExpired || status == StatusFailed) {
+		r.config.OnFinish(*it)
+	}
+	if r.store.records > 2*len(r.items)+100 {
+		return r.compact()
+	}
+	return nil
+}
+
+// compact drops finished commands older than the retention period and rewrites the store file
+func (r *OfflineQueue) compact() error {
+	deadline := time.Now().Add(-r.config.Retention)
+	for id, it := range r.items {
+		finished := it.Status == StatusAnswered || it.Status == StatusExpired || it.Status == StatusFailed
+		if finished && it.UpdatedAt.Before(deadline) {
+			delete(r.items, id)
+		}
+	}
+	return r.store.rewrite(r.items)
+}
+
+// fileStore append-only JSON Lines journal of queued command states, the last record of a command wins
+type fileStore struct {
+	path    string
+	file    *os.File
+	records int
+}
+
+func openFileStore(path string) (*fileStore, map[uint64]*QueuedCommand, error) {
+	items := map[uint64]*QueuedCommand{}
+	file, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("queue store open error (%v)", err)
+	}
+	if err == nil {
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		line := 0
+		var lastErr error
+		for scanner.Scan() {
+			line++
+			if lastErr != nil {
+				_ = file.Close()
+				return nil, nil, lastErr
+			}
+			it := &QueuedCommand{}
+			if err = json.Unmarshal(scanner.Bytes(), it); err != nil {
+				// a torn write is possible only in the last line
+				lastErr = fmt.Errorf("queue store '%s' line %d is corrupted (%v)", path, line, err)
+				continue
+			}
+			items[it.Id] = it
+		}
+		_ = file.Close()
+		if err = scanner.Err(); err != nil {
+			return nil, nil, fmt.Errorf("queue store read error (%v)", err)
+		}
+	}
+	store := &fileStore{path: path}
+	if err = store.rewrite(items); err != nil {
+		return nil, nil, err
+	}
+	return store, items, nil
+}
+
+func (r *fileStore) put(it *QueuedCommand) error {
+	data, err := json.Marshal(it)
+	if err != nil {
+		return err
+	}
+	if _, err = r.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("queue store write error (%v)", err)
+	}
+	if err = r.file.Sync(); err != nil {
+		return fmt.Errorf("queue store sync error (%v)", err)
+	}
+	r.records++
+	return nil
+}
+
+// rewrite atomically replaces the file with the current items and reopens it for appending
+func (r *fileStore) rewrite(items map[uint64]*QueuedCommand) error {
+	ids := make([]uint64, 0, len(items))
+	for id := range items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	tmp := r.path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("queue store create error (%v)", err)
+	}
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, id := range ids {
+		if err = encoder.Encode(items[id]); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, r.path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("queue store write error (%v)", err)
+	}
+
+	if r.file != nil {
+		_ = r.file.Close()
+	}
+	if r.file, err = os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return fmt.Errorf("queue store open error (%v)", err)
+	}
+	r.records = len(ids)
+	return nil
+}
+
+func (r *fileStore) close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
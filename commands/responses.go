@@ -0,0 +1,305 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KeyValue single "Key:Value" pair of a response
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// KeyValues "Key:Value Key:Value" response, in order of appearance
+type KeyValues []KeyValue
+
+// Info parsed "getinfo" response
+type Info struct {
+	GPSState   int           `json:"gpsState"`
+	Satellites int           `json:"satellites"`
+	Records    int           `json:"records"`
+	Uptime     time.Duration `json:"uptime"`
+	Values     KeyValues     `json:"values"`
+}
+
+// Version parsed "getver" response
+type Version struct {
+	Firmware  string    `json:"firmware"`
+	GPSModule string    `json:"gpsModule"`
+	Hardware  string    `json:"hardware"`
+	Modem     string    `json:"modem"`
+	IMEI      string    `json:"imei"`
+	Values    KeyValues `json:"values"`
+}
+
+// Status parsed "getstatus" response
+type Status struct {
+	DataLink bool      `json:"dataLink"`
+	GPRS     bool      `json:"gprs"`
+	Operator string    `json:"operator"`
+	Signal   int       `json:"signal"`
+	Roaming  bool      `json:"roaming"`
+	LAC      int       `json:"lac"`
+	CellID   int       `json:"cellId"`
+	Values   KeyValues `json:"values"`
+}
+
+// GPSInfo parsed "getgps" response
+type GPSInfo struct {
+	Valid      bool      `json:"valid"`
+	Satellites int       `json:"satellites"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	Altitude   float64   `json:"altitude"`
+	Speed      float64   `json:"speed"`
+	Direction  float64   `json:"direction"`
+	Time       time.Time `json:"time"`
+}
+
+// IOState parsed "getio" response, maps are indexed by the input/output number (DI1 -> 1)
+type IOState struct {
+	DigitalInputs  map[int]bool    `json:"digitalInputs"`
+	DigitalOutputs map[int]bool    `json:"digitalOutputs"`
+	AnalogInputs   map[int]float64 `json:"analogInputs"`
+}
+
+// IOValue parsed "readio" response
+type IOValue struct {
+	Id    uint16 `json:"id"`
+	Value string `json:"value"`
+}
+
+// multi word keys are listed explicitly, otherwise a value word followed by a key is ambiguous
+var keyRegexp = regexp.MustCompile(`(?:^|[\s;])(Data Link|Cell ID|Param ID|IO ID|New value|[A-Za-z][A-Za-z0-9_.]*):`)
+
+// ParseKeyValues parses "Key:Value Key: Value" response, values may contain spaces and colons
+func ParseKeyValues(text string) (KeyValues, error) {
+	text = strings.TrimSpace(text)
+	matches := keyRegexp.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 || strings.TrimSpace(text[:matches[0][0]]) != "" {
+		return nil, fmt.Errorf("unable to parse response '%s', expected Key:Value pairs", text)
+	}
+	res := make(KeyValues, 0, len(matches))
+	for i, m := range matches {
+		end := len(text)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		value := strings.TrimSpace(text[m[1]:end])
+		res = append(res, KeyValue{Key: text[m[2]:m[3]], Value: strings.TrimSuffix(value, ";")})
+	}
+	return res, nil
+}
+
+// Get returns value of the first key matching case-insensitively
+func (r KeyValues) Get(key string) (string, bool) {
+	for _, it := range r {
+		if strings.EqualFold(it.Key, key) {
+			return it.Value, true
+		}
+	}
+	return "", false
+}
+
+func (r KeyValues) str(key string) string {
+	v, _ := r.Get(key)
+	return v
+}
+
+func (r KeyValues) int(key string) int {
+	v, _ := strconv.Atoi(r.str(key))
+	return v
+}
+
+func (r KeyValues) float(key string) float64 {
+	v, _ := strconv.ParseFloat(r.str(key), 64)
+	return v
+}
+
+func (r KeyValues) bool(key string) bool {
+	return r.int(key) != 0
+}
+
+// ParseInfo parses "getinfo" response
+func ParseInfo(text string) (*Info, error) {
+	values, err := ParseKeyValues(text)
+	if err != nil {
+		return nil, err
+	}
+	uptime, _ := strconv.Atoi(strings.TrimSuffix(values.str("UpTime"), "s"))
+	return &Info{
+		GPSState:   values.int("GPS"),
+		Satellites: values.int("SAT"),
+		Records:    values.int("REC"),
+		Uptime:     time.Duration(uptime) * time.Second,
+		Values:     values,
+	}, nil
+}
+
+// ParseVersion parses "getver" response
+func ParseVersion(text string) (*Version, error) {
+	values, err := ParseKeyValues(text)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := values.Get("Ver"); !ok {
+		return nil, fmt.Errorf("unable to parse getver response '%s', 'Ver' not found", text)
+	}
+	return &Version{
+		Firmware:  values.str("Ver"),
+		GPSModule: values.str("GPS"),
+		Hardware:  values.str("Hw"),
+		Modem:     values.str("Mod"),
+		IMEI:      values.str("IMEI"),
+		Values:    values,
+	}, nil
+}
+
+// ParseStatus parses "getstatus" response
+func ParseStatus(text string) (*Status, error) {
+	values, err := ParseKeyValues(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Status{
+		DataLink: values.bool("Data Link"),
+		GPRS:     values.bool("GPRS"),
+		Operator: values.str("OP"),
+		Signal:   values.int("Signal"),
+		Roaming:  values.bool("Roaming"),
+		LAC:      values.int("LAC"),
+		CellID:   values.int("Cell ID"),
+		Values:   values,
+	}, nil
+}
+
+// ParseGPS parses "getgps" response
+func ParseGPS(text string) (*GPSInfo, error) {
+	values, err := ParseKeyValues(text)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := values.Get("Lat"); !ok {
+		return nil, fmt.Errorf("unable to parse getgps response '%s', 'Lat' not found", text)
+	}
+	res := &GPSInfo{
+		Valid:      values.bool("GPS"),
+		Satellites: values.int("Sat"),
+		Lat:        values.float("Lat"),
+		Lng:        values.float("Long"),
+		Altitude:   values.float("Alt"),
+		Speed:      values.float("Speed"),
+		Direction:  values.float("Dir"),
+	}
+	date, okDate := values.Get("Date")
+	clock, okTime := values.Get("Time")
+	if okDate && okTime {
+		t, err := time.Parse("2006/1/2 15:4:5", date+" "+clock)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse getgps date '%s %s' (%v)", date, clock, err)
+		}
+		res.Time = t
+	}
+	return res, nil
+}
+
+// ParseIO parses "getio" response
+func ParseIO(text string) (*IOState, error) {
+	values, err := ParseKeyValues(text)
+	if err != nil {
+		return nil, err
+	}
+	res := &IOState{
+		DigitalInputs:  map[int]bool{},
+		DigitalOutputs: map[int]bool{},
+		AnalogInputs:   map[int]float64{},
+	}
+	for _, it := range values {
+		key := strings.ToUpper(it.Key)
+		var prefix string
+		for _, p := range []string{"DI", "DO", "AIN"} {
+			if strings.HasPrefix(key, p) {
+				prefix = p
+			}
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(key, prefix))
+		if prefix == "" || err != nil {
+			continue
+		}
+		value, err := strconv.ParseFloat(it.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse getio value %s:%s", it.Key, it.Value)
+		}
+		switch prefix {
+		case "DI":
+			res.DigitalInputs[n] = value != 0
+		case "DO":
+			res.DigitalOutputs[n] = value != 0
+		case "AIN":
+			res.AnalogInputs[n] = value
+		}
+	}
+	return res, nil
+}
+
+// ParseReadIO parses "readio" response, e.g. "IO ID:21 Value:3"
+func ParseReadIO(text string) (*IOValue, error) {
+	values, err := ParseKeyValues(text)
+	if err != nil {
+		return nil, err
+	}
+	idStr, ok := values.Get("IO ID")
+	value, okValue := values.Get("Value")
+	if !ok || !okValue {
+		return nil, fmt.Errorf("unable to parse readio response '%s'", text)
+	}
+	id, err := strconv.ParseUint(idStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse readio response '%s', invalid id (%v)", text, err)
+	}
+	return &IOValue{Id: uint16(id), Value: value}, nil
+}
+
+// ParseParams parses "getparam" and "setparam" responses: "Param ID:2001 Value:internet",
+// "New value 2001:internet;2002:user" or "2001:internet;2002:user"
+func ParseParams(text string) (map[uint32]string, error) {
+	text = strings.TrimSpace(text)
+	res := map[uint32]string{}
+	if strings.HasPrefix(text, "Param ID:") {
+		for _, part := range strings.Split(text, "Param ID:")[1:] {
+			idStr, value, ok := strings.Cut(part, " Value:")
+			if !ok {
+				return nil, fmt.Errorf("unable to parse params response '%s'", text)
+			}
+			id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse params response '%s', invalid id (%v)", text, err)
+			}
+			res[uint32(id)] = strings.TrimSuffix(strings.TrimSpace(value), ";")
+		}
+		return res, nil
+	}
+	text = strings.TrimSpace(strings.TrimPrefix(text, "New value"))
+	for _, pair := range strings.Split(strings.TrimSuffix(text, ";"), ";") {
+		idStr, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("unable to parse params response '%s'", text)
+		}
+		id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse params response '%s', invalid id (%v)", text, err)
+		}
+		res[uint32(id)] = value
+	}
+	return res, nil
+}
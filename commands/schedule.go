@@ -0,0 +1,174 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule returns the next activation time after the given one
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+// interval "@every <duration>" schedule
+type interval time.Duration
+
+// cronSchedule standard 5 fields cron expression: minute hour day-of-month month day-of-week
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64 // bit sets
+	domAny, dowAny                bool
+}
+
+var cronAliases = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+var cronDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseSchedule parses cron expression ("0 3 * * mon", "*/15 * * * *"), alias (@daily, @weekly...)
+// or interval ("@every 1h30m")
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(spec[len("@every "):]))
+		if err != nil || d < time.Second {
+			return nil, fmt.Errorf("invalid schedule '%s', expected @every <duration> (at least 1s)", spec)
+		}
+		return interval(d), nil
+	}
+	if alias, ok := cronAliases[spec]; ok {
+		spec = alias
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule '%s', expected 5 cron fields", spec)
+	}
+	res := &cronSchedule{domAny: fields[2] == "*", dowAny: fields[4] == "*"}
+	var err error
+	if res.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s', minute: %v", spec, err)
+	}
+	if res.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s', hour: %v", spec, err)
+	}
+	if res.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s', day of month: %v", spec, err)
+	}
+	if res.month, err = parseCronField(fields[3], 1, 12, cronMonths); err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s', month: %v", spec, err)
+	}
+	if res.dow, err = parseCronField(fields[4], 0, 7, cronDays); err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s', day of week: %v", spec, err)
+	}
+	// 7 is Sunday too
+	if res.dow&(1<<7) != 0 {
+		res.dow |= 1
+	}
+	return res, nil
+}
+
+func (r interval) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(r))
+}
+
+// Next returns the next matching minute, zero time if there is none within 5 years (e.g. "0 0 30 2 *")
+func (r *cronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if r.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !r.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if r.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if r.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches day of month and day of week are OR-ed if both are restricted, like in cron
+func (r *cronSchedule) dayMatches(t time.Time) bool {
+	dom := r.dom&(1<<uint(t.Day())) != 0
+	dow := r.dow&(1<<uint(t.Weekday())) != 0
+	if r.domAny || r.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// parseCronField parses "*", "1,5", "1-5", "*/15", "1-30/2" or names (mon, jan) into a bit set
+func parseCronField(field string, min, max int, names []string) (uint64, error) {
+	var res uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s'", stepPart)
+			}
+		}
+		from, to := min, max
+		if rangePart != "*" {
+			fromStr, toStr, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if from, err = parseCronValue(fromStr, min, max, names); err != nil {
+				return 0, err
+			}
+			to = from
+			if isRange {
+				if to, err = parseCronValue(toStr, min, max, names); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				to = max
+			}
+			if from > to {
+				return 0, fmt.Errorf("invalid range '%s'", rangePart)
+			}
+		}
+		for i := from; i <= to; i += step {
+			res |= 1 << uint(i)
+		}
+	}
+	return res, nil
+}
+
+func parseCronValue(value string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			return i + min, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("invalid value '%s', expected %d-%d", value, min, max)
+	}
+	return n, nil
+}
@@ -0,0 +1,317 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Device known device and its attributes used to select job targets
+type Device struct {
+	Imei  string   `json:"imei"`
+	Model string   `json:"model,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// DeviceSet selects devices: all, or matching any of the listed imeis, tags or models
+type DeviceSet struct {
+	All    bool     `json:"all,omitempty"`
+	Imeis  []string `json:"imeis,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	Models []string `json:"models,omitempty"`
+}
+
+// Job scheduled command
+type Job struct {
+	Name     string    `json:"name"`
+	Schedule string    `json:"schedule"` // see ParseSchedule
+	Command  string    `json:"command"`
+	Targets  DeviceSet `json:"targets"`
+	Priority int       `json:"priority,omitempty"`
+	TTL      string    `json:"ttl,omitempty"` // queued command lifetime, e.g. "6h", OfflineQueue default if empty
+}
+
+// JobStatus job and its activation times
+type JobStatus struct {
+	Job     Job       `json:"job"`
+	Next    time.Time `json:"next"`
+	LastRun time.Time `json:"lastRun,omitempty"`
+	Queued  int       `json:"queued"` // commands queued by the last run
+}
+
+// JobResult result of the command queued by a job
+type JobResult struct {
+	Job        string      `json:"job"`
+	Imei       string      `json:"imei"`
+	CommandId  uint64      `json:"commandId"`
+	Command    string      `json:"command"`
+	QueuedAt   time.Time   `json:"queuedAt"`
+	FinishedAt time.Time   `json:"finishedAt"`
+	Status     QueueStatus `json:"status"`
+	Response   string      `json:"response,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// SchedulerConfig Scheduler options
+type SchedulerConfig struct {
+	Devices     func() []Device   // known devices, required
+	OnQueued    func(imei string) // called after a command is queued, e.g. to start delivery to an online device
+	ResultsPath string            // JSON Lines file the results are appended to, results are not stored if empty
+	OnError     func(err error)   // called if a scheduled run fails to queue commands
+}
+
+// Scheduler queues job commands to the OfflineQueue at the scheduled times.
+// A device is skipped if the command of the previous job run is still waiting in the queue
+type Scheduler struct {
+	mu      sync.Mutex
+	queue   *OfflineQueue
+	config  SchedulerConfig
+	jobs    []*scheduledJob
+	results *os.File
+}
+
+type scheduledJob struct {
+	job      Job
+	schedule Schedule
+	command  *Command
+	ttl      time.Duration
+	status   JobStatus
+}
+
+// NewScheduler create new Scheduler, job schedules and commands are validated.
+// Call HandleFinished from QueueConfig.OnFinish to store the results
+func NewScheduler(queue *OfflineQueue, jobs []Job, config SchedulerConfig) (*Scheduler, error) {
+	if config.Devices == nil {
+		return nil, fmt.Errorf("scheduler: devices provider is required")
+	}
+	res := &Scheduler{queue: queue, config: config}
+	names := map[string]bool{}
+	now := time.Now()
+	for _, it := range jobs {
+		if it.Name == "" || names[it.Name] {
+			return nil, fmt.Errorf("scheduler: job name '%s' is empty or not unique", it.Name)
+		}
+		names[it.Name] = true
+		schedule, err := ParseSchedule(it.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler: job '%s': %v", it.Name, err)
+		}
+		cmd, err := ParseCommand(it.Command)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler: job '%s': %v", it.Name, err)
+		}
+		job := &scheduledJob{job: it, schedule: schedule, command: cmd}
+		if it.TTL != "" {
+			if job.ttl, err = time.ParseDuration(it.TTL); err != nil {
+				return nil, fmt.Errorf("scheduler: job '%s': invalid ttl (%v)", it.Name, err)
+			}
+		}
+		job.status = JobStatus{Job: it, Next: schedule.Next(now)}
+		res.jobs = append(res.jobs, job)
+	}
+	if config.ResultsPath != "" {
+		file, err := os.OpenFile(config.ResultsPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler: results file open error (%v)", err)
+		}
+		res.results = file
+	}
+	return res, nil
+}
+
+// Run fires jobs at their scheduled times until the context is canceled, missed activations
+// (e.g. while the process was stopped) are not repeated
+func (r *Scheduler) Run(ctx context.Context) error {
+	for {
+		r.mu.Lock()
+		var next time.Time
+		for _, it := range r.jobs {
+			if !it.status.Next.IsZero() && (next.IsZero() || it.status.Next.Before(next)) {
+				next = it.status.Next
+			}
+		}
+		r.mu.Unlock()
+
+		if next.IsZero() {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		now := time.Now()
+		r.mu.Lock()
+		var due []*scheduledJob
+		for _, it := range r.jobs {
+			if !it.status.Next.IsZero() && !it.status.Next.After(now) {
+				it.status.Next = it.schedule.Next(now)
+				due = append(due, it)
+			}
+		}
+		r.mu.Unlock()
+		for _, it := range due {
+			if _, err := r.fire(it); err != nil && r.config.OnError != nil {
+				r.config.OnError(err)
+			}
+		}
+	}
+}
+
+// RunJob fires the job immediately, returns the number of queued commands
+func (r *Scheduler) RunJob(name string) (int, error) {
+	r.mu.Lock()
+	var job *scheduledJob
+	for _, it := range r.jobs {
+		if it.job.Name == name {
+			job = it
+		}
+	}
+	r.mu.Unlock()
+	if job == nil {
+		return 0, fmt.Errorf("job '%s' not found", name)
+	}
+	return r.fire(job)
+}
+
+// Jobs returns jobs with their activation times
+func (r *Scheduler) Jobs() []JobStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]JobStatus, 0, len(r.jobs))
+	for _, it := range r.jobs {
+		res = append(res, it.status)
+	}
+	return res
+}
+
+// HandleFinished stores the result of a command queued by a job, to be used as QueueConfig.OnFinish
+func (r *Scheduler) HandleFinished(it QueuedCommand) {
+	if it.Job == "" || r.results == nil {
+		return
+	}
+	data, err := json.Marshal(&JobResult{
+		Job: it.Job, Imei: it.Imei, CommandId: it.Id, Command: it.Command, QueuedAt: it.CreatedAt,
+		FinishedAt: it.UpdatedAt, Status: it.Status, Response: it.Response, Error: it.Error,
+	})
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, _ = r.results.Write(append(data, '\n'))
+}
+
+// Results returns stored results of the job (all jobs if name is empty), at most limit most recent ones if limit > 0
+func (r *Scheduler) Results(name string, limit int) ([]*JobResult, error) {
+	res := make([]*JobResult, 0)
+	if r.config.ResultsPath == "" {
+		return res, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, err := os.Open(r.config.ResultsPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		it := &JobResult{}
+		if json.Unmarshal(scanner.Bytes(), it) != nil || name != "" && it.Job != name {
+			continue
+		}
+		res = append(res, it)
+		if limit > 0 && len(res) > limit {
+			res = res[1:]
+		}
+	}
+	return res, scanner.Err()
+}
+
+// Close closes the results file
+func (r *Scheduler) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.results == nil {
+		return nil
+	}
+	return r.results.Close()
+}
+
+// fire queues the job command for all target devices, returns the number of queued commands
+func (r *Scheduler) fire(job *scheduledJob) (int, error) {
+	waiting := map[string]bool{}
+	for _, it := range r.queue.List("") {
+		if it.Job == job.job.Name && (it.Status == StatusQueued || it.Status == StatusSent) {
+			waiting[it.Imei] = true
+		}
+	}
+	queued := 0
+	for _, device := range r.config.Devices() {
+		if !job.job.Targets.Match(&device) || waiting[device.Imei] {
+			continue
+		}
+		_, err := r.queue.Enqueue(device.Imei, job.command, &EnqueueOptions{
+			Priority: job.job.Priority, TTL: job.ttl, Requester: "scheduler", Job: job.job.Name,
+		})
+		if err != nil {
+			return queued, fmt.Errorf("scheduler: job '%s': %v", job.job.Name, err)
+		}
+		queued++
+		if r.config.OnQueued != nil {
+			r.config.OnQueued(device.Imei)
+		}
+	}
+	r.mu.Lock()
+	job.status.LastRun = time.Now()
+	job.status.Queued = queued
+	r.mu.Unlock()
+	return queued, nil
+}
+
+// Match reports whether the device belongs to the set
+func (r *DeviceSet) Match(device *Device) bool {
+	if r.All {
+		return true
+	}
+	for _, it := range r.Imeis {
+		if it == device.Imei {
+			return true
+		}
+	}
+	for _, it := range r.Models {
+		if device.Model != "" && strings.EqualFold(it, device.Model) {
+			return true
+		}
+	}
+	for _, tag := range r.Tags {
+		for _, it := range device.Tags {
+			if tag == it {
+				return true
+			}
+		}
+	}
+	return false
+}
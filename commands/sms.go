@@ -0,0 +1,231 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxSMSLength max length of the SMS command text including login and password
+const MaxSMSLength = 160
+
+// DefaultSMSTimeout default time to wait for the SMS reply
+const DefaultSMSTimeout = time.Minute * 10
+
+// SMS text message received from or sent to the device phone number
+type SMS struct {
+	Phone string    `json:"phone"`
+	Text  string    `json:"text"`
+	Time  time.Time `json:"time"`
+}
+
+// SMSDevice phone number and SMS credentials of the device
+type SMSDevice struct {
+	Imei     string `json:"imei"`
+	Phone    string `json:"phone"`
+	Login    string `json:"login,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// SMSGateway sends SMS to devices and delivers their replies to the receiver
+type SMSGateway interface {
+	SendSMS(phone string, text string) error
+	// SetReceiver sets the callback for incoming messages, Dispatcher sets it to Dispatcher.HandleSMS
+	SetReceiver(receiver func(sms *SMS))
+}
+
+// FormatSMS returns the command in the Teltonika SMS syntax "<login> <password> <command>",
+// empty login and password are kept as empty fields (the text starts with two spaces)
+func FormatSMS(device *SMSDevice, cmd *Command) (string, error) {
+	if cmd.Payload != nil {
+		return "", fmt.Errorf("binary command can not be sent by SMS")
+	}
+	if strings.Contains(device.Login, " ") || strings.Contains(device.Password, " ") {
+		return "", fmt.Errorf("SMS login and password must not contain spaces")
+	}
+	text := device.Login + " " + device.Password + " " + cmd.String()
+	if len(text) > MaxSMSLength {
+		return "", fmt.Errorf("SMS command exceeds %d characters", MaxSMSLength)
+	}
+	return text, nil
+}
+
+// samePhone compares phone numbers by digits, "+370 600 00000" and "37060000000" are the same
+func samePhone(a, b string) bool {
+	digits := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, s)
+	}
+	return digits(a) != "" && digits(a) == digits(b)
+}
+
+// HTTPSMSGateway SMSGateway which sends messages to the HTTP endpoint of an SMS provider as
+// JSON {"phone": "...", "text": "..."} POST requests, incoming messages are delivered to ServeHTTP
+// as JSON {"phone": "...", "text": "..."} or form values phone (or from) and text
+type HTTPSMSGateway struct {
+	URL    string
+	Header http.Header  // additional request headers, e.g. Authorization
+	Client *http.Client // http.DefaultClient if nil
+	// Verify checks that the incoming message is sent by the SMS provider, e.g. SMSTokenVerifier,
+	// a forged reply would complete the in-flight command. All messages are accepted if nil
+	Verify   func(req *http.Request) bool
+	mu       sync.Mutex
+	receiver func(sms *SMS)
+}
+
+// SMSTokenVerifier returns HTTPSMSGateway.Verify function accepting requests with the shared token
+// in the X-SMS-Token header or the token query param
+func SMSTokenVerifier(token string) func(req *http.Request) bool {
+	return func(req *http.Request) bool {
+		value := req.Header.Get("X-SMS-Token")
+		if value == "" {
+			value = req.URL.Query().Get("token")
+		}
+		return token != "" && subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1
+	}
+}
+
+// NewHTTPSMSGateway create new HTTPSMSGateway
+func NewHTTPSMSGateway(url string) *HTTPSMSGateway {
+	return &HTTPSMSGateway{URL: url, Header: http.Header{}}
+}
+
+func (r *HTTPSMSGateway) SendSMS(phone string, text string) error {
+	body, err := json.Marshal(&SMS{Phone: phone, Text: text, Time: time.Now()})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, r.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("SMS gateway request error (%v)", err)
+	}
+	for key, values := range r.Header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("SMS gateway request error (%v)", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("SMS gateway error (%s: %s)", res.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+func (r *HTTPSMSGateway) SetReceiver(receiver func(sms *SMS)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.receiver = receiver
+}
+
+// ServeHTTP accepts incoming messages from the SMS provider
+func (r *HTTPSMSGateway) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "POST expected", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.Verify != nil && !r.Verify(req) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	sms := &SMS{}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(io.LimitReader(req.Body, 64*1024)).Decode(sms); err != nil {
+			http.Error(w, fmt.Sprintf("invalid message (%v)", err), http.StatusBadRequest)
+			return
+		}
+	} else {
+		sms.Phone, sms.Text = req.FormValue("phone"), req.FormValue("text")
+		if sms.Phone == "" {
+			sms.Phone = req.FormValue("from")
+		}
+	}
+	if sms.Phone == "" {
+		http.Error(w, "phone is required", http.StatusBadRequest)
+		return
+	}
+	if sms.Time.IsZero() {
+		sms.Time = time.Now()
+	}
+	r.mu.Lock()
+	receiver := r.receiver
+	r.mu.Unlock()
+	if receiver != nil {
+		receiver(sms)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MemorySMSGateway in-memory SMSGateway for tests, sent messages are stored, replies are injected with Deliver
+type MemorySMSGateway struct {
+	// OnSend is called for every sent message, e.g. to Deliver the reply, optional
+	OnSend   func(sms SMS)
+	Err      error // returned by SendSMS if not nil
+	mu       sync.Mutex
+	sent     []SMS
+	receiver func(sms *SMS)
+}
+
+func (r *MemorySMSGateway) SendSMS(phone string, text string) error {
+	r.mu.Lock()
+	if r.Err != nil {
+		r.mu.Unlock()
+		return r.Err
+	}
+	sms := SMS{Phone: phone, Text: text, Time: time.Now()}
+	r.sent = append(r.sent, sms)
+	onSend := r.OnSend
+	r.mu.Unlock()
+	if onSend != nil {
+		go onSend(sms)
+	}
+	return nil
+}
+
+func (r *MemorySMSGateway) SetReceiver(receiver func(sms *SMS)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.receiver = receiver
+}
+
+// Sent returns the sent messages
+func (r *MemorySMSGateway) Sent() []SMS {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]SMS(nil), r.sent...)
+}
+
+// Deliver passes the incoming message to the receiver
+func (r *MemorySMSGateway) Deliver(phone string, text string) {
+	r.mu.Lock()
+	receiver := r.receiver
+	r.mu.Unlock()
+	if receiver != nil {
+		receiver(&SMS{Phone: phone, Text: text, Time: time.Now()})
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+const (
+	DefaultTunnelChunkSize  = 1024
+	DefaultTunnelBufferSize = 64 * 1024
+)
+
+var (
+	// ErrTunnelBusy returned when a tunnel to the device is already open
+	ErrTunnelBusy = errors.New("tunnel to the device is already open")
+	// ErrTunnelOpen returned by Dispatcher.Send while a tunnel to the device is open,
+	// responses to commands can not be told apart from the serial data
+	ErrTunnelOpen = errors.New("command can not be sent while a tunnel to the device is open")
+)
+
+// TunnelConfig Tunnel options
+type TunnelConfig struct {
+	ChunkSize  int // max data size of one Codec 12 message, DefaultTunnelChunkSize if 0
+	BufferSize int // max size of received and not read data, the oldest data is dropped, DefaultTunnelBufferSize if 0
+}
+
+// TunnelAddr address of the tunnel end, the device imei
+type TunnelAddr string
+
+func (r TunnelAddr) Network() string { return "teltonika" }
+
+func (r TunnelAddr) String() string { return string(r) }
+
+// Tunnel serial link of the device in "TCP Binary" or "TCP ASCII" passthrough mode, implements net.Conn.
+// Written data is sent to the device in Codec 12 command messages, data received from the serial
+// peripheral arrives in Codec 12 response messages and is passed to the tunnel by Tunnels.HandlePacket
+type Tunnel struct {
+	imei      string
+	transport Transport
+	config    TunnelConfig
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	notify    chan struct{} // closed when data arrives, the tunnel is closed or the read deadline is changed
+	closed    bool
+	dropped   int
+	rDeadline time.Time
+	wDeadline time.Time
+	onClose   func()
+	wLock     sync.Mutex
+}
+
+// Tunnels opens tunnels and routes device data to them
+type Tunnels struct {
+	transport Transport
+	config    TunnelConfig
+	mu        sync.Mutex
+	tunnels   map[string]*Tunnel
+}
+
+// NewTunnels create new Tunnels
+func NewTunnels(transport Transport, config ...*TunnelConfig) *Tunnels {
+	cfg := TunnelConfig{}
+	if len(config) > 0 && config[0] != nil {
+		cfg = *config[0]
+	}
+	if cfg.ChunkSize <= 0 {
+		cfg.ChunkSize = DefaultTunnelChunkSize
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultTunnelBufferSize
+	}
+	return &Tunnels{transport: transport, config: cfg, tunnels: map[string]*Tunnel{}}
+}
+
+// Open opens the tunnel to the device, only one tunnel per device can be open
+func (r *Tunnels) Open(imei string) (*Tunnel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.tunnels[imei]; ok {
+		return nil, ErrTunnelBusy
+	}
+	tunnel := &Tunnel{imei: imei, transport: r.transport, config: r.config, notify: make(chan struct{})}
+	tunnel.onClose = func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.tunnels[imei] == tunnel {
+			delete(r.tunnels, imei)
+		}
+	}
+	r.tunnels[imei] = tunnel
+	return tunnel, nil
+}
+
+// IsOpen reports whether a tunnel to the device is open
+func (r *Tunnels) IsOpen(imei string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.tunnels[imei]
+	return ok
+}
+
+// Close closes the open tunnel to the device, the connection piped to it is closed too.
+// Returns false if no tunnel is open
+func (r *Tunnels) Close(imei string) bool {
+	r.mu.Lock()
+	tunnel, ok := r.tunnels[imei]
+	r.mu.Unlock()
+	if !ok {
+		return false
+	}
+	_ = tunnel.Close()
+	return true
+}
+
+// HandlePacket passes Codec 12 responses to the open tunnel of the device, returns false if the packet
+// is not consumed. While the tunnel is open all Codec 12 responses of the device are treated as serial data,
+// pass the packet to Dispatcher.HandlePacket first to complete the command sent before the tunnel was opened
+// and set DispatcherConfig.Tunnels to refuse new commands
+func (r *Tunnels) HandlePacket(imei string, packet *teltonika.Packet) bool {
+	if packet.CodecID != teltonika.Codec12 {
+		return false
+	}
+	r.mu.Lock()
+	tunnel, ok := r.tunnels[imei]
+	r.mu.Unlock()
+	if !ok {
+		return false
+	}
+	for i := range packet.Messages {
+		if packet.Messages[i].Type == teltonika.TypeResponse {
+			tunnel.receive(packet.Messages[i].Bytes())
+		}
+	}
+	return true
+}
+
+// Listen accepts local TCP connections on the address and connects them to the device tunnel, one at a time,
+// a connection accepted while the tunnel is open is closed. Close the listener to stop
+func (r *Tunnels) Listen(imei string, address string) (net.Listener, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("tunnel listener create error (%v)", err)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			tunnel, err := r.Open(imei)
+			if err != nil {
+				_ = conn.Close()
+				continue
+			}
+			go Pipe(conn, tunnel)
+		}
+	}()
+	return listener, nil
+}
+
+// Pipe copies data between the connection and the tunnel in both directions until one of them is closed,
+// then closes both
+func Pipe(conn net.Conn, tunnel *Tunnel) {
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(tunnel, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, tunnel)
+		done <- struct{}{}
+	}()
+	<-done
+	_ = conn.Close()
+	_ = tunnel.Close()
+	<-done
+}
+
+// Dropped returns the number of received bytes dropped because the buffer was full
+func (r *Tunnel) Dropped() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.dropped
+}
+
+func (r *Tunnel) receive(data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed || len(data) == 0 {
+		return
+	}
+	r.buf.Write(data)
+	if excess := r.buf.Len() - r.config.BufferSize; excess > 0 {
+		r.buf.Next(excess)
+		r.dropped += excess
+	}
+	r.wake()
+}
+
+// wake wakes up the waiting reader, must be called with r.mu held
+func (r *Tunnel) wake() {
+	close(r.notify)
+	r.notify = make(chan struct{})
+}
+
+// Read reads data received from the serial peripheral, returns io.EOF after the tunnel is closed
+func (r *Tunnel) Read(p []byte) (int, error) {
+	for {
+		r.mu.Lock()
+		if r.buf.Len() > 0 {
+			n, _ := r.buf.Read(p)
+			r.mu.Unlock()
+			return n, nil
+		}
+		if r.closed {
+			r.mu.Unlock()
+			return 0, io.EOF
+		}
+		deadline, notify := r.rDeadline, r.notify
+		r.mu.Unlock()
+
+		if deadline.IsZero() {
+			<-notify
+			continue
+		}
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return 0, os.ErrDeadlineExceeded
+		}
+		// the deadline is checked again after the timer fires, it may have been changed while waiting
+		timer := time.NewTimer(wait)
+		select {
+		case <-notify:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// Write sends the data to the serial peripheral, the data is split into Codec 12 messages of ChunkSize bytes
+func (r *Tunnel) Write(p []byte) (int, error) {
+	r.wLock.Lock()
+	defer r.wLock.Unlock()
+	written := 0
+	for written < len(p) {
+		r.mu.Lock()
+		closed, deadline := r.closed, r.wDeadline
+		r.mu.Unlock()
+		if closed {
+			return written, net.ErrClosed
+		}
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			return written, os.ErrDeadlineExceeded
+		}
+		end := written + r.config.ChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		chunk := make([]byte, end-written)
+		copy(chunk, p[written:end])
+		if err := r.transport.SendPacket(r.imei, Binary(chunk).Packet()); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
+
+// Close closes the tunnel, the device stays in the passthrough mode
+func (r *Tunnel) Close() error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	r.wake()
+	r.mu.Unlock()
+	if r.onClose != nil {
+		r.onClose()
+	}
+	return nil
+}
+
+func (r *Tunnel) LocalAddr() net.Addr { return TunnelAddr("") }
+
+func (r *Tunnel) RemoteAddr() net.Addr { return TunnelAddr(r.imei) }
+
+func (r *Tunnel) SetDeadline(t time.Time) error {
+	_ = r.SetReadDeadline(t)
+	return r.SetWriteDeadline(t)
+}
+
+func (r *Tunnel) SetReadDeadline(t time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rDeadline = t
+	r.wake()
+	return nil
+}
+
+func (r *Tunnel) SetWriteDeadline(t time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.wDeadline = t
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+// DeviceMessage message sent by the device on its own (Codec 13 or Codec 15), not a command response
+type DeviceMessage struct {
+	Imei       string                   `json:"imei"`
+	Codec      teltonika.CodecId        `json:"codec"`
+	Type       teltonika.MessageType    `json:"type"`
+	Timestamp  time.Time                `json:"timestamp"`
+	ReceivedAt time.Time                `json:"receivedAt"`
+	Text       string                   `json:"text"`
+	Payload    teltonika.MessagePayload `json:"payload,omitempty"`
+}
+
+// ImeiMismatchError Codec 15 message imei differs from the imei of the session
+type ImeiMismatchError struct {
+	Session string
+	Message string
+}
+
+func (r *ImeiMismatchError) Error() string {
+	return fmt.Sprintf("message imei '%s' does not match the session imei '%s'", r.Message, r.Session)
+}
+
+// UnsolicitedHandler routes Codec 13 and Codec 15 messages to the sink
+type UnsolicitedHandler struct {
+	Sink func(message *DeviceMessage)
+	// OnError is called for messages dropped because of the imei mismatch, optional
+	OnError func(err error)
+}
+
+// IsUnsolicited reports whether the packet carries device originated messages (Codec 13 or Codec 15)
+func IsUnsolicited(packet *teltonika.Packet) bool {
+	return packet.CodecID == teltonika.Codec13 || packet.CodecID == teltonika.Codec15
+}
+
+// DeviceMessages converts Codec 13 and Codec 15 messages of the packet received in the session of the device
+// with the imei, Codec 15 messages with other imei are returned as errors
+func DeviceMessages(imei string, packet *teltonika.Packet) ([]*DeviceMessage, []error) {
+	if !IsUnsolicited(packet) {
+		return nil, nil
+	}
+	receivedAt := time.Now()
+	var res []*DeviceMessage
+	var errs []error
+	for i := range packet.Messages {
+		message := &packet.Messages[i]
+		if packet.CodecID == teltonika.Codec15 && !sameImei(message.Imei, imei) {
+			errs = append(errs, &ImeiMismatchError{Session: imei, Message: message.Imei})
+			continue
+		}
+		res = append(res, &DeviceMessage{
+			Imei: imei, Codec: packet.CodecID, Type: message.Type, ReceivedAt: receivedAt,
+			Timestamp: time.Unix(int64(message.Timestamp), 0).UTC(), Text: message.Text, Payload: message.Payload,
+		})
+	}
+	return res, errs
+}
+
+// HandlePacket passes Codec 13 and Codec 15 messages to the sink, returns false if the packet
+// is not a device originated one
+func (r *UnsolicitedHandler) HandlePacket(imei string, packet *teltonika.Packet) bool {
+	if !IsUnsolicited(packet) {
+		return false
+	}
+	messages, errs := DeviceMessages(imei, packet)
+	if r.OnError != nil {
+		for _, err := range errs {
+			r.OnError(err)
+		}
+	}
+	if r.Sink != nil {
+		for _, it := range messages {
+			r.Sink(it)
+		}
+	}
+	return true
+}
+
+// sameImei compares imei strings ignoring leading zeros, the decoder trims them in Codec 14 and Codec 15 messages
+func sameImei(a, b string) bool {
+	return strings.TrimLeft(a, "0") == strings.TrimLeft(b, "0")
+}
@@ -9,22 +9,29 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/alim-zanibekov/teltonika"
+	"github.com/alim-zanibekov/teltonika/commands"
 	"github.com/alim-zanibekov/teltonika/ioelements"
+	"github.com/alim-zanibekov/teltonika/params"
 )
 
 var decodeConfig = &teltonika.DecodeConfig{IoElementsAlloc: teltonika.OnReadBuffer}
@@ -200,7 +207,7 @@ func (r *TCPServer) handleConnection(conn net.Conn) {
 	//? Proposito: Convierte los dos bytes leídos en un entero que representa la longitud del IMEI
 	//? Explicación: [binary.BigEndian.Uint16(buf)] convierte los primero 2 bytes a un entero sin signo de 16 bits usando el orden de bytes big-endiand
 	imeiLen := int(binary.BigEndian.Uint16(buf))
-	logger.Info.Printf("[%s]: imei lengh recibed (%s)", imeiLen)
+	logger.Info.Printf("[%s]: imei lengh recibed (%d)", logKey, imeiLen)
 	//? =====================================
 
 
@@ -249,9 +256,6 @@ func (r *TCPServer) handleConnection(conn net.Conn) {
 
 	logKey = fmt.Sprintf("%s-%s", imei, addr)
 
-	if r.OnConnect != nil {
-		r.OnConnect(imei)
-	}
 	logger.Info.Printf("[%s]: imei - %s", logKey, client.imei)
 	storedClient, loaded := r.clients.LoadOrStore(imei, client)
 
@@ -274,6 +278,11 @@ func (r *TCPServer) handleConnection(conn net.Conn) {
 		return
 	}
 
+	// the tracker accepts commands only after the imei is acknowledged
+	if r.OnConnect != nil {
+		r.OnConnect(imei)
+	}
+
 	readBuffer := make([]byte, 1300)
 	reader := bufio.NewReader(conn)
 	for {
@@ -332,19 +341,84 @@ func (r *TCPServer) handleConnection(conn net.Conn) {
 }
 
 type HTTPServer struct {
-	address  string
-	hub      TrackersHub
-	respChan *sync.Map
-	logger   *Logger
+	address    string
+	hub        TrackersHub
+	dispatcher *commands.Dispatcher
+	queue      *commands.OfflineQueue
+	audit      *commands.AuditLog
+	interlock  *commands.Interlock
+	scheduler  *commands.Scheduler
+	tunnels    *commands.Tunnels
+	messages   *deviceMessages
+	incoming   *commands.UnsolicitedHandler
+	sms        *commands.HTTPSMSGateway
+	smsDevices map[string]*commands.SMSDevice
+	users      map[string]string // basic auth user name -> password used to verify requesters
+	listeners  *sync.Map
+	devices    *sync.Map
+	profiles   *sync.Map
+	reports    *sync.Map
+	applying   *sync.Map
+	logger     *Logger
 }
 
 //goland:noinspection GoUnusedExportedFunction
 func NewHTTPServer(address string, hub TrackersHub) *HTTPServer {
-	return &HTTPServer{address: address, respChan: &sync.Map{}, hub: hub}
+	return NewHTTPServerLogger(address, hub, &Logger{log.Default(), log.Default()})
 }
 
 func NewHTTPServerLogger(address string, hub TrackersHub, logger *Logger) *HTTPServer {
-	return &HTTPServer{address: address, respChan: &sync.Map{}, hub: hub, logger: logger}
+	hs := &HTTPServer{
+		address: address, hub: hub, logger: logger, interlock: commands.NewInterlock(nil), devices: &sync.Map{},
+		profiles: &sync.Map{}, reports: &sync.Map{}, applying: &sync.Map{},
+		tunnels: commands.NewTunnels(hub), listeners: &sync.Map{},
+		messages: &deviceMessages{items: map[string][]*commands.DeviceMessage{}},
+	}
+	hs.incoming = &commands.UnsolicitedHandler{
+		Sink: func(message *commands.DeviceMessage) {
+			logger.Info.Printf("[%s]: device message (codec %d, type 0x%X, %s): %s",
+				message.Imei, message.Codec, message.Type, message.Timestamp.Format(time.RFC3339), message.Text)
+			hs.messages.add(message)
+		},
+		OnError: func(err error) {
+			logger.Error.Printf("device message dropped (%v)", err)
+		},
+	}
+	hs.dispatcher = commands.NewDispatcher(hub, hs.dispatcherConfig())
+	return hs
+}
+
+// lateResponseWindow how long a late answer to a timed out command is expected, see commands.DispatcherConfig
+const lateResponseWindow = time.Second * 30
+
+func (hs *HTTPServer) dispatcherConfig() *commands.DispatcherConfig {
+	config := &commands.DispatcherConfig{
+		OnComplete: hs.recordCommand, Interlock: hs.interlock, Tunnels: hs.tunnels, LateResponseWindow: lateResponseWindow,
+	}
+	if hs.sms != nil {
+		config.SMS = hs.sms
+		config.SMSDevice = func(imei string) *commands.SMSDevice {
+			return hs.smsDevices[imei]
+		}
+	}
+	return config
+}
+
+// enableSMS sends commands to offline trackers from the list by SMS, replies are received at /sms,
+// must be called before the dispatcher is used
+func (hs *HTTPServer) enableSMS(gateway *commands.HTTPSMSGateway, devices []commands.SMSDevice) {
+	hs.sms = gateway
+	hs.smsDevices = map[string]*commands.SMSDevice{}
+	for i := range devices {
+		hs.smsDevices[devices[i].Imei] = &devices[i]
+	}
+	hs.dispatcher = commands.NewDispatcher(hs.hub, hs.dispatcherConfig())
+}
+
+// pendingProfile profile waiting to be applied and identity of its author
+type pendingProfile struct {
+	profile   *commands.Profile
+	requester string
 }
 
 func (hs *HTTPServer) Run() error {
@@ -356,6 +430,32 @@ func (hs *HTTPServer) Run() error {
 
 	handler.HandleFunc("/list-clients", hs.listClients)
 
+	handler.HandleFunc("/profile", hs.handleProfile)
+
+	handler.HandleFunc("/compliance", hs.handleCompliance)
+
+	handler.HandleFunc("/config-diff", hs.handleConfigDiff)
+
+	handler.HandleFunc("/queue", hs.handleQueue)
+
+	handler.HandleFunc("/audit", hs.handleAudit)
+
+	handler.HandleFunc("/interlock", hs.handleInterlock)
+
+	handler.HandleFunc("/jobs", hs.handleJobs)
+
+	handler.HandleFunc("/jobs/run", hs.handleJobRun)
+
+	handler.HandleFunc("/jobs/results", hs.handleJobResults)
+
+	handler.HandleFunc("/tunnel", hs.handleTunnel)
+
+	handler.HandleFunc("/messages", hs.handleMessages)
+
+	if hs.sms != nil {
+		handler.Handle("/sms", hs.sms)
+	}
+
 	logger.Info.Println("http server listening at " + hs.address)
 
 	err := http.ListenAndServe(hs.address, handler)
@@ -365,22 +465,56 @@ func (hs *HTTPServer) Run() error {
 	return nil
 }
 
-func (hs *HTTPServer) WriteMessage(imei string, message *teltonika.Message) {
-	ch, ok := hs.respChan.Load(imei)
-	if ok {
-		select {
-		case ch.(chan *teltonika.Message) <- message:
-		}
+// HandlePacket stores device originated (Codec 13 and 15) messages and passes command responses
+// to the dispatcher or the serial tunnel, returns false if the packet has no matching responses.
+// Commands are refused while the tunnel is open, so the dispatcher matches only the command sent before that
+func (hs *HTTPServer) HandlePacket(imei string, packet *teltonika.Packet) bool {
+	if hs.incoming.HandlePacket(imei, packet) {
+		return true
 	}
+	if hs.dispatcher.HandlePacket(imei, packet) {
+		return true
+	}
+	return hs.tunnels.HandlePacket(imei, packet)
 }
 
-func (hs *HTTPServer) ClientDisconnected(imei string) {
-	ch, ok := hs.respChan.Load(imei)
-	if ok {
-		select {
-		case ch.(chan *teltonika.Message) <- nil:
+func (hs *HTTPServer) ClientConnected(imei string) {
+	hs.devices.LoadOrStore(imei, &commands.Device{Imei: imei})
+	if hs.queue != nil {
+		go hs.deliverQueued(imei)
+	}
+	if _, ok := hs.profiles.Load(imei); ok {
+		go hs.applyProfile(imei)
+	}
+}
+
+func (hs *HTTPServer) deliverQueued(imei string) {
+	if err := hs.queue.Deliver(context.Background(), imei); err != nil {
+		hs.logger.Error.Printf("[%s]: offline queue delivery error (%v)", imei, err)
+	}
+}
+
+// listDevices returns devices from the inventory file and trackers connected since the start
+func (hs *HTTPServer) listDevices() []commands.Device {
+	var res []commands.Device
+	hs.devices.Range(func(key, value interface{}) bool {
+		res = append(res, *value.(*commands.Device))
+		return true
+	})
+	return res
+}
+
+func (hs *HTTPServer) isOnline(imei string) bool {
+	for _, it := range hs.hub.ListClients() {
+		if it.Imei == imei {
+			return true
 		}
 	}
+	return false
+}
+
+func (hs *HTTPServer) ClientDisconnected(imei string) {
+	hs.dispatcher.Disconnected(imei)
 }
 
 func (hs *HTTPServer) listClients(w http.ResponseWriter, _ *http.Request) {
@@ -393,64 +527,553 @@ func (hs *HTTPServer) listClients(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
-func (hs *HTTPServer) handleCmd(w http.ResponseWriter, r *http.Request) {
-	logger := hs.logger
+func (hs *HTTPServer) recordCommand(record *commands.CommandRecord) {
+	// the model of devices missing in the inventory is learned from getver responses
+	if strings.HasPrefix(record.Command, "getver") && record.Error == "" {
+		if version, err := commands.ParseVersion(record.Response); err == nil && version.Hardware != "" {
+			if value, ok := hs.devices.Load(record.Imei); ok && value.(*commands.Device).Model == "" {
+				device := *value.(*commands.Device)
+				device.Model = version.Hardware
+				hs.devices.Store(record.Imei, &device)
+			}
+		}
+	}
+	if hs.audit == nil {
+		return
+	}
+	if err := hs.audit.Record(record); err != nil {
+		hs.logger.Error.Printf("audit log error (%v)", err)
+	}
+}
+
+// requester returns identity of the request author verified with the users file, see commands.Requester
+func (hs *HTTPServer) requester(r *http.Request) string {
+	return commands.Requester(r, hs.users)
+}
+
+// recordQueued audits queued commands that finished without reaching the dispatcher (expired or invalid),
+// the dispatcher audits the others
+func (hs *HTTPServer) recordQueued(it commands.QueuedCommand) {
+	if _, err := commands.ParseCommand(it.Command); err == nil && it.Status != commands.StatusExpired {
+		return
+	}
+	codec := teltonika.Codec12
+	if it.Codec14 {
+		codec = teltonika.Codec14
+	}
+	errText := it.Error
+	if it.Status == commands.StatusExpired {
+		errText = "expired in the offline queue"
+	}
+	hs.recordCommand(&commands.CommandRecord{
+		Requester: it.Requester, Imei: it.Imei, Codec: codec, Command: it.Command, QueuedAt: it.CreatedAt, Error: errText,
+	})
+}
+
+// execute sends the command and waits for the tracker response, commands to the same tracker are sent one by one
+func (hs *HTTPServer) execute(ctx context.Context, imei string, cmd *commands.Command, config *commands.SendConfig) (*teltonika.Message, error) {
+	hs.logger.Info.Printf("command '%s' queued for '%s'", cmd, imei)
+	return hs.dispatcher.Send(ctx, imei, cmd, config)
+}
+
+func (hs *HTTPServer) executor(ctx context.Context, imei string, requester string) commands.Executor {
+	return func(cmd *commands.Command) (string, error) {
+		msg, err := hs.execute(ctx, imei, cmd, &commands.SendConfig{Timeout: time.Minute, Requester: requester})
+		if err != nil {
+			return "", err
+		}
+		return commands.ResponseText(msg)
+	}
+}
 
+func (hs *HTTPServer) handleCmd(w http.ResponseWriter, r *http.Request) {
 	params := r.URL.Query()
 	imei := params.Get("imei")
-	buf := make([]byte, 512)
-	n, _ := r.Body.Read(buf)
-	cmd := string(buf[:n])
+	cmd, err := commands.ReadCommand(r)
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+		return
+	}
 
-	packet := &teltonika.Packet{
-		CodecID:  teltonika.Codec12,
-		Data:     nil,
-		Messages: []teltonika.Message{{Type: teltonika.TypeCommand, Text: strings.TrimSpace(cmd)}},
+	config := &commands.SendConfig{Codec14: params.Get("codec") == "14", Requester: hs.requester(r)}
+	if timeout := params.Get("timeout"); timeout != "" {
+		if config.Timeout, err = time.ParseDuration(timeout); err != nil {
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": fmt.Sprintf("invalid timeout (%v)", err)})
+			return
+		}
 	}
 
-	result := make(chan *teltonika.Message, 1)
-	defer close(result)
-	for {
-		if _, loaded := hs.respChan.LoadOrStore(imei, result); !loaded {
-			break
+	// offline commands are sent by SMS instead of the queue if sms=1
+	if hs.queue != nil && params.Get("sms") != "1" && (params.Get("queue") == "1" || !hs.isOnline(imei)) {
+		hs.enqueue(w, imei, cmd, config, params)
+		return
+	}
+
+	msg, err := hs.execute(r.Context(), imei, cmd, config)
+	var interlockErr *commands.InterlockError
+	switch {
+	case errors.Is(err, commands.ErrDisconnected):
+		hs.writeJson(w, http.StatusServiceUnavailable, map[string]interface{}{"error": err.Error()})
+	case errors.Is(err, commands.ErrTimeout):
+		hs.writeJson(w, http.StatusGatewayTimeout, map[string]interface{}{"error": err.Error()})
+	case errors.Is(err, commands.ErrQueueFull):
+		hs.writeJson(w, http.StatusTooManyRequests, map[string]interface{}{"error": err.Error()})
+	case errors.Is(err, commands.ErrTunnelOpen):
+		hs.writeJson(w, http.StatusConflict, map[string]interface{}{"error": err.Error()})
+	case errors.As(err, &interlockErr):
+		hs.writeJson(w, http.StatusConflict, map[string]interface{}{"error": err.Error(), "interlock": interlockErr.Decision})
+	case errors.Is(err, context.Canceled):
+		hs.logger.Info.Printf("command '%s' to '%s' canceled by the client", cmd, imei)
+	case err != nil && !errors.Is(err, commands.ErrNotExecuted):
+		hs.logger.Error.Printf("send packet error (%v)", err)
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+	default:
+		hs.writeJson(w, http.StatusOK, commands.CommandResult(cmd, msg, params.Get("format")))
+	}
+}
+
+// handleProfile stores the configuration profile for the tracker, the profile is applied
+// immediately if the tracker is online, otherwise on the next connection
+func (hs *HTTPServer) handleProfile(w http.ResponseWriter, r *http.Request) {
+	imei := r.URL.Query().Get("imei")
+	if r.Method != http.MethodPost || imei == "" {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "POST /profile?imei=... expected"})
+		return
+	}
+	profile, err := readProfile(r)
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if err = profile.Validate(nil, ""); err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	hs.profiles.Store(imei, &pendingProfile{profile: profile, requester: hs.requester(r)})
+
+	status := "pending"
+	if hs.isOnline(imei) {
+		status = "applying"
+		go hs.applyProfile(imei)
+	}
+	hs.writeJson(w, http.StatusAccepted, map[string]interface{}{"status": status})
+}
+
+// handleConfigDiff reads parameters of the profile (JSON or .cfg) from the tracker and returns the differing ones
+func (hs *HTTPServer) handleConfigDiff(w http.ResponseWriter, r *http.Request) {
+	imei := r.URL.Query().Get("imei")
+	if r.Method != http.MethodPost || imei == "" {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "POST /config-diff?imei=... expected"})
+		return
+	}
+	profile, err := readProfile(r)
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	desired := profile.Values()
+	current, err := commands.ReadParams(hs.executor(r.Context(), imei, hs.requester(r)), desired.Ids(), 0)
+	if err != nil {
+		hs.writeJson(w, http.StatusBadGateway, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	changes := params.Diff(current, desired)
+	if changes == nil {
+		changes = []params.ValueChange{}
+	}
+	hs.writeJson(w, http.StatusOK, changes)
+}
+
+// readProfile reads JSON profile or Teltonika Configurator .cfg file (profile name is taken from the 'name' query param)
+func readProfile(r *http.Request) (*commands.Profile, error) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("request read error (%v)", err)
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		profile := &commands.Profile{}
+		if err = json.Unmarshal(data, profile); err != nil {
+			return nil, fmt.Errorf("invalid profile (%v)", err)
 		}
-		time.Sleep(time.Millisecond * 100)
+		return profile, nil
+	}
+	values, err := params.ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid .cfg file (%v)", err)
 	}
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		name = "cfg"
+	}
+	return commands.NewProfile(name, values), nil
+}
 
-	defer hs.respChan.Delete(imei)
+// enqueue stores the command in the offline queue, optional query params: priority, ttl, attempts
+func (hs *HTTPServer) enqueue(w http.ResponseWriter, imei string, cmd *commands.Command, config *commands.SendConfig, params url.Values) {
+	options := &commands.EnqueueOptions{Codec14: config.Codec14, Requester: config.Requester}
+	var err error
+	if v := params.Get("priority"); v != "" {
+		options.Priority, err = strconv.Atoi(v)
+	}
+	if v := params.Get("ttl"); v != "" && err == nil {
+		options.TTL, err = time.ParseDuration(v)
+	}
+	if v := params.Get("attempts"); v != "" && err == nil {
+		options.MaxAttempts, err = strconv.Atoi(v)
+	}
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": fmt.Sprintf("invalid queue options (%v)", err)})
+		return
+	}
+	it, err := hs.queue.Enqueue(imei, cmd, options)
+	if err != nil {
+		hs.writeJson(w, http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	hs.logger.Info.Printf("command '%s' for '%s' stored in the offline queue (id %d)", cmd, imei, it.Id)
+	if hs.isOnline(imei) {
+		go hs.deliverQueued(imei)
+	}
+	hs.writeJson(w, http.StatusAccepted, it)
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := hs.hub.SendPacket(imei, packet); err != nil {
-		logger.Error.Printf("send packet error (%v)", err)
-		w.WriteHeader(http.StatusBadRequest)
-		body, _ := json.Marshal(map[string]interface{}{"error": err.Error()})
-		_, err = w.Write(body)
+// handleQueue returns the queued command by id or commands of the tracker (all if imei is empty)
+func (hs *HTTPServer) handleQueue(w http.ResponseWriter, r *http.Request) {
+	if hs.queue == nil {
+		hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "offline queue is disabled"})
+		return
+	}
+	params := r.URL.Query()
+	if v := params.Get("id"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 64)
 		if err != nil {
-			logger.Error.Printf("http write error (%v)", err)
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "invalid id"})
+			return
 		}
-	} else {
-		logger.Info.Printf("command '%s' sent to '%s'", cmd, imei)
-		ticker := time.NewTimer(time.Minute * 3)
-		defer ticker.Stop()
-
-		select {
-		case msg := <-result:
-			if msg != nil {
-				body, _ := json.Marshal(map[string]interface{}{"response": msg.Text})
-				_, err = w.Write(body)
-			} else {
-				w.WriteHeader(http.StatusServiceUnavailable)
-				_, err = w.Write([]byte(`{"error":"tracker disconnected"}`))
+		it, ok := hs.queue.Get(id)
+		if !ok {
+			hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "command not found"})
+			return
+		}
+		hs.writeJson(w, http.StatusOK, it)
+		return
+	}
+	hs.writeJson(w, http.StatusOK, hs.queue.List(params.Get("imei")))
+}
+
+// handleAudit returns command history, query params: imei, requester, from, to (RFC 3339), limit,
+// format (json, jsonl or csv)
+func (hs *HTTPServer) handleAudit(w http.ResponseWriter, r *http.Request) {
+	if hs.audit == nil {
+		hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "audit log is disabled"})
+		return
+	}
+	params := r.URL.Query()
+	filter := commands.AuditFilter{Imei: params.Get("imei"), Requester: params.Get("requester")}
+	var err error
+	if v := params.Get("from"); v != "" {
+		filter.From, err = time.Parse(time.RFC3339, v)
+	}
+	if v := params.Get("to"); v != "" && err == nil {
+		filter.To, err = time.Parse(time.RFC3339, v)
+	}
+	if v := params.Get("limit"); v != "" && err == nil {
+		filter.Limit, err = strconv.Atoi(v)
+	}
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": fmt.Sprintf("invalid filter (%v)", err)})
+		return
+	}
+	records, err := hs.audit.Query(filter)
+	if err != nil {
+		hs.writeJson(w, http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	switch params.Get("format") {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="command-audit.csv"`)
+		err = commands.WriteAuditCsv(w, records)
+	case "jsonl":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		encoder := json.NewEncoder(w)
+		for _, it := range records {
+			if err = encoder.Encode(it); err != nil {
+				break
 			}
-		case <-ticker.C:
-			w.WriteHeader(http.StatusGatewayTimeout)
-			_, err = w.Write([]byte(`{"error":"tracker response timeout exceeded"}`))
 		}
+	default:
+		hs.writeJson(w, http.StatusOK, records)
+	}
+	if err != nil {
+		hs.logger.Error.Printf("http write error (%v)", err)
+	}
+}
 
+// handleInterlock returns interlock rules and the vehicle state of the tracker, if the cmd query param
+// is set - the current decision for the command
+func (hs *HTTPServer) handleInterlock(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	imei := params.Get("imei")
+	res := map[string]interface{}{"rules": hs.interlock.Rules()}
+	if state, ok := hs.interlock.State(imei); ok {
+		res["state"] = state
+	}
+	if text := params.Get("cmd"); text != "" {
+		cmd, err := commands.ParseCommand(text)
 		if err != nil {
-			logger.Error.Printf("http write error (%v)", err)
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+			return
+		}
+		res["decision"] = hs.interlock.Check(imei, cmd)
+	}
+	hs.writeJson(w, http.StatusOK, res)
+}
+
+func (hs *HTTPServer) handleJobs(w http.ResponseWriter, _ *http.Request) {
+	if hs.scheduler == nil {
+		hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "scheduler is disabled"})
+		return
+	}
+	hs.writeJson(w, http.StatusOK, hs.scheduler.Jobs())
+}
+
+// handleJobRun runs the job immediately
+func (hs *HTTPServer) handleJobRun(w http.ResponseWriter, r *http.Request) {
+	if hs.scheduler == nil {
+		hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "scheduler is disabled"})
+		return
+	}
+	if r.Method != http.MethodPost {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "POST /jobs/run?name=... expected"})
+		return
+	}
+	queued, err := hs.scheduler.RunJob(r.URL.Query().Get("name"))
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	hs.writeJson(w, http.StatusOK, map[string]interface{}{"queued": queued})
+}
+
+// handleJobResults returns results of the job commands, query params: name, limit
+func (hs *HTTPServer) handleJobResults(w http.ResponseWriter, r *http.Request) {
+	if hs.scheduler == nil {
+		hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "scheduler is disabled"})
+		return
+	}
+	params := r.URL.Query()
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	results, err := hs.scheduler.Results(params.Get("name"), limit)
+	if err != nil {
+		hs.writeJson(w, http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	hs.writeJson(w, http.StatusOK, results)
+}
+
+// maxDeviceMessages number of the last device messages kept for every tracker
+const maxDeviceMessages = 100
+
+// deviceMessages last device originated messages of every tracker
+type deviceMessages struct {
+	mu    sync.Mutex
+	items map[string][]*commands.DeviceMessage
+}
+
+func (r *deviceMessages) add(message *commands.DeviceMessage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	items := append(r.items[message.Imei], message)
+	if len(items) > maxDeviceMessages {
+		items = items[len(items)-maxDeviceMessages:]
+	}
+	r.items[message.Imei] = items
+}
+
+// list returns messages of the tracker (all trackers if imei is empty), the newest last
+func (r *deviceMessages) list(imei string, limit int) []*commands.DeviceMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]*commands.DeviceMessage, 0)
+	for key, items := range r.items {
+		if imei == "" || key == imei {
+			res = append(res, items...)
 		}
 	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].ReceivedAt.Before(res[j].ReceivedAt) })
+	if limit > 0 && len(res) > limit {
+		res = res[len(res)-limit:]
+	}
+	return res
+}
+
+// handleMessages returns the last device originated messages, query params: imei, limit
+func (hs *HTTPServer) handleMessages(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	hs.writeJson(w, http.StatusOK, hs.messages.list(params.Get("imei"), limit))
+}
+
+// tunnelListener local TCP listener connected to the serial link of the tracker
+type tunnelListener struct {
+	Imei     string `json:"imei"`
+	Address  string `json:"address"`
+	Open     bool   `json:"open"`
+	listener net.Listener
+}
+
+// handleTunnel opens (POST) or closes (DELETE) a local TCP listener connected to the serial link
+// of the tracker in passthrough mode, DELETE closes the active connection too, GET lists the listeners
+func (hs *HTTPServer) handleTunnel(w http.ResponseWriter, r *http.Request) {
+	imei := r.URL.Query().Get("imei")
+	switch r.Method {
+	case http.MethodGet:
+		res := make([]*tunnelListener, 0)
+		hs.listeners.Range(func(key, value interface{}) bool {
+			it := *value.(*tunnelListener)
+			it.Open = hs.tunnels.IsOpen(it.Imei)
+			res = append(res, &it)
+			return true
+		})
+		hs.writeJson(w, http.StatusOK, res)
+	case http.MethodPost:
+		if imei == "" {
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "imei is required"})
+			return
+		}
+		if _, ok := hs.listeners.Load(imei); ok {
+			hs.writeJson(w, http.StatusConflict, map[string]interface{}{"error": "tunnel listener already exists"})
+			return
+		}
+		address := r.URL.Query().Get("address")
+		if address == "" {
+			address = "127.0.0.1:0"
+		}
+		if !isLoopback(address) {
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "tunnel address must be a loopback address"})
+			return
+		}
+		listener, err := hs.tunnels.Listen(imei, address)
+		if err != nil {
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+			return
+		}
+		it := &tunnelListener{Imei: imei, Address: listener.Addr().String(), listener: listener}
+		if _, loaded := hs.listeners.LoadOrStore(imei, it); loaded {
+			_ = listener.Close()
+			hs.writeJson(w, http.StatusConflict, map[string]interface{}{"error": "tunnel listener already exists"})
+			return
+		}
+		hs.logger.Info.Printf("[%s]: serial tunnel listening at %s", imei, it.Address)
+		hs.writeJson(w, http.StatusOK, it)
+	case http.MethodDelete:
+		value, ok := hs.listeners.Load(imei)
+		if !ok {
+			hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "tunnel listener not found"})
+			return
+		}
+		hs.listeners.Delete(imei)
+		if err := value.(*tunnelListener).listener.Close(); err != nil {
+			hs.logger.Error.Printf("[%s]: tunnel listener close error (%v)", imei, err)
+		}
+		// the accepted connection keeps the tunnel open, commands to the tracker are refused until it is closed
+		hs.tunnels.Close(imei)
+		hs.writeJson(w, http.StatusOK, map[string]interface{}{"status": "closed"})
+	default:
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": "GET, POST or DELETE /tunnel?imei=... expected"})
+	}
+}
+
+// isLoopback reports whether the listen address is on the loopback interface, the tunnel has no authentication
+// and must not expose the serial port of the tracker to the network
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// readJsonFile reads the JSON file into value
+func readJsonFile(path string, value interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("%s parse error (%v)", path, err)
+	}
+	return nil
+}
+
+// loadInterlockRules reads interlock rules from the JSON file
+func loadInterlockRules(path string) ([]commands.InterlockRule, error) {
+	var rules []commands.InterlockRule
+	if err := readJsonFile(path, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (hs *HTTPServer) handleCompliance(w http.ResponseWriter, r *http.Request) {
+	imei := r.URL.Query().Get("imei")
+	if imei != "" {
+		report, ok := hs.reports.Load(imei)
+		if !ok {
+			hs.writeJson(w, http.StatusNotFound, map[string]interface{}{"error": "no compliance report"})
+			return
+		}
+		hs.writeJson(w, http.StatusOK, report)
+		return
+	}
+	res := map[string]interface{}{}
+	hs.reports.Range(func(key, value interface{}) bool {
+		res[key.(string)] = value
+		return true
+	})
+	hs.writeJson(w, http.StatusOK, res)
+}
+
+func (hs *HTTPServer) applyProfile(imei string) {
+	logger := hs.logger
+	if _, loaded := hs.applying.LoadOrStore(imei, true); loaded {
+		return
+	}
+	defer hs.applying.Delete(imei)
+
+	value, ok := hs.profiles.Load(imei)
+	if !ok {
+		return
+	}
+	pending := value.(*pendingProfile)
+	profile := pending.profile
+
+	report, err := commands.ApplyProfile(hs.executor(context.Background(), imei, pending.requester), profile, &commands.ApplyConfig{Retries: 2})
+	hs.reports.Store(imei, report)
+	if err != nil {
+		// the profile stays pending and is applied again on the next connection
+		logger.Error.Printf("[%s]: profile '%s' apply error (%v)", imei, profile.Name, err)
+		return
+	}
+	if current, ok := hs.profiles.Load(imei); ok && current == pending {
+		hs.profiles.Delete(imei)
+	}
+	logger.Info.Printf("[%s]: profile '%s' applied, compliant: %v", imei, profile.Name, report.Compliant)
+}
+
+func (hs *HTTPServer) writeJson(w http.ResponseWriter, status int, value interface{}) {
+	body, _ := json.Marshal(value)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		hs.logger.Error.Printf("http write error (%v)", err)
+	}
 }
 
 func main() {
@@ -459,11 +1082,31 @@ func main() {
 	var outHook string
 	var readTimeout time.Duration
 	var writeTimeout time.Duration
+	var queueFile string
+	var auditFile string
+	var interlockFile string
+	var jobsFile string
+	var devicesFile string
+	var jobResultsFile string
+	var smsGatewayUrl string
+	var smsDevicesFile string
+	var smsToken string
+	var usersFile string
 	flag.StringVar(&tcpAddress, "address", "0.0.0.0:8080", "tcp server address")
 	flag.StringVar(&httpAddress, "http", "0.0.0.0:8081", "http server address")
 	flag.StringVar(&outHook, "hook", "", "output hook\nfor example: http://localhost:8080/push")
 	flag.DurationVar(&readTimeout, "read-timeout", time.Minute*2, "receive timeout")
 	flag.DurationVar(&writeTimeout, "write-timeout", time.Minute*2, "send timeout")
+	flag.StringVar(&queueFile, "queue", "offline-queue.jsonl", "offline command queue file, empty to disable")
+	flag.StringVar(&auditFile, "audit", "command-audit.jsonl", "command audit log file, empty to disable")
+	flag.StringVar(&interlockFile, "interlocks", "", "interlock rules json file")
+	flag.StringVar(&jobsFile, "jobs", "", "scheduled jobs json file, requires the offline queue")
+	flag.StringVar(&devicesFile, "devices", "", "devices inventory json file (imei, model, tags) used to select job targets")
+	flag.StringVar(&jobResultsFile, "job-results", "job-results.jsonl", "scheduled jobs results file")
+	flag.StringVar(&smsGatewayUrl, "sms-gateway", "", "SMS gateway url, commands to offline trackers are sent by SMS")
+	flag.StringVar(&smsDevicesFile, "sms-devices", "", "json file with tracker phone numbers and SMS credentials (imei, phone, login, password)")
+	flag.StringVar(&smsToken, "sms-token", "", "shared token the SMS gateway passes to /sms in the X-SMS-Token header or the token query param")
+	flag.StringVar(&usersFile, "users", "", "json file with basic auth users ({\"name\": \"password\"}) used to verify the requester identity")
 	flag.Parse()
 
 	logger := &Logger{
@@ -474,15 +1117,111 @@ func main() {
 	serverTcp := NewTCPServerLogger(tcpAddress, logger)
 	serverHttp := NewHTTPServerLogger(httpAddress, serverTcp, logger)
 
-	serverTcp.writeTimeout = writeTimeout
-	serverTcp.readTimeout = readTimeout
-	serverTcp.OnPacket = func(imei string, pkt *teltonika.Packet) {
-		if pkt.Messages != nil && len(pkt.Messages) > 0 {
-			serverHttp.WriteMessage(imei, &pkt.Messages[0])
+	if smsGatewayUrl != "" {
+		var devices []commands.SMSDevice
+		if smsDevicesFile != "" {
+			if err := readJsonFile(smsDevicesFile, &devices); err != nil {
+				panic(err)
+			}
 		}
-		if pkt.Data != nil && outHook != "" {
-			go hookSend(outHook, imei, pkt, logger)
+		if smsToken == "" {
+			panic("SMS gateway requires the shared token (-sms-token) to verify replies")
+		}
+		gateway := commands.NewHTTPSMSGateway(smsGatewayUrl)
+		gateway.Verify = commands.SMSTokenVerifier(smsToken)
+		serverHttp.enableSMS(gateway, devices)
+	}
+
+	if usersFile != "" {
+		if err := readJsonFile(usersFile, &serverHttp.users); err != nil {
+			panic(err)
+		}
+	}
+
+	if interlockFile != "" {
+		rules, err := loadInterlockRules(interlockFile)
+		if err != nil {
+			panic(err)
+		}
+		serverHttp.interlock.SetRules(rules)
+	}
+
+	if auditFile != "" {
+		audit, err := commands.OpenAuditLog(auditFile)
+		if err != nil {
+			panic(err)
+		}
+		serverHttp.audit = audit
+	}
+
+	if devicesFile != "" {
+		var devices []commands.Device
+		if err := readJsonFile(devicesFile, &devices); err != nil {
+			panic(err)
+		}
+		for i := range devices {
+			serverHttp.devices.Store(devices[i].Imei, &devices[i])
+		}
+	}
+
+	if jobsFile != "" && queueFile == "" {
+		panic("scheduled jobs require the offline queue (-queue)")
+	}
+
+	if queueFile != "" {
+		queue, err := commands.OpenOfflineQueue(queueFile, serverHttp.dispatcher, &commands.QueueConfig{
+			OnFinish: func(it commands.QueuedCommand) {
+				serverHttp.recordQueued(it)
+				if serverHttp.scheduler != nil {
+					serverHttp.scheduler.HandleFinished(it)
+				}
+			},
+		})
+		if err != nil {
+			panic(err)
 		}
+		serverHttp.queue = queue
+		go func() {
+			for range time.Tick(time.Minute) {
+				if err := queue.Expire(); err != nil {
+					logger.Error.Printf("offline queue expire error (%v)", err)
+				}
+			}
+		}()
+	}
+
+	if jobsFile != "" {
+		var jobs []commands.Job
+		if err := readJsonFile(jobsFile, &jobs); err != nil {
+			panic(err)
+		}
+		scheduler, err := commands.NewScheduler(serverHttp.queue, jobs, commands.SchedulerConfig{
+			Devices:     serverHttp.listDevices,
+			ResultsPath: jobResultsFile,
+			OnQueued: func(imei string) {
+				if serverHttp.isOnline(imei) {
+					go serverHttp.deliverQueued(imei)
+				}
+			},
+			OnError: func(err error) {
+				logger.Error.Printf("scheduler error (%v)", err)
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		serverHttp.scheduler = scheduler
+		go func() {
+			_ = scheduler.Run(context.Background())
+		}()
+	}
+
+	serverTcp.writeTimeout = writeTimeout
+	serverTcp.readTimeout = readTimeout
+	serverTcp.OnPacket = packetHandler(serverHttp, outHook, logger)
+
+	serverTcp.OnConnect = func(imei string) {
+		serverHttp.ClientConnected(imei)
 	}
 
 	serverTcp.OnClose = func(imei string) {
@@ -495,6 +1234,22 @@ func main() {
 	panic(serverHttp.Run())
 }
 
+// packetHandler returns the TCPServer.OnPacket handler, AVL data updates the vehicle state used by interlocks
+// and is sent to the output hook, messages are passed to HTTPServer.HandlePacket
+func packetHandler(hs *HTTPServer, outHook string, logger *Logger) func(imei string, pkt *teltonika.Packet) {
+	return func(imei string, pkt *teltonika.Packet) {
+		if len(pkt.Data) > 0 {
+			hs.interlock.Update(imei, pkt)
+		}
+		if len(pkt.Messages) > 0 && !hs.HandlePacket(imei, pkt) {
+			logger.Info.Printf("[%s]: unexpected response: %s", imei, pkt.Messages[0].Text)
+		}
+		if pkt.Data != nil && outHook != "" {
+			go hookSend(outHook, imei, pkt, logger)
+		}
+	}
+}
+
 func buildJsonPacket(imei string, pkt *teltonika.Packet) []byte {
 	if pkt.Data == nil {
 		return nil
@@ -0,0 +1,111 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alim-zanibekov/teltonika"
+	"github.com/alim-zanibekov/teltonika/commands"
+	"github.com/alim-zanibekov/teltonika/ioelements"
+)
+
+// testHub answers every command through the packet handler
+type testHub struct {
+	onPacket func(imei string, pkt *teltonika.Packet)
+}
+
+func (r *testHub) SendPacket(imei string, packet *teltonika.Packet) error {
+	r.onPacket(imei, &teltonika.Packet{CodecID: teltonika.Codec12, Messages: []teltonika.Message{
+		{Type: teltonika.TypeResponse, Text: "DOUT1:1"},
+	}})
+	return nil
+}
+
+func (r *testHub) ListClients() []*Client {
+	return nil
+}
+
+func TestPacketHandlerInterlock(t *testing.T) {
+	logger := &Logger{Info: log.New(io.Discard, "", 0), Error: log.New(io.Discard, "", 0)}
+	hub := &testHub{}
+	hs := NewHTTPServerLogger("", hub, logger)
+	hs.interlock.SetRules([]commands.InterlockRule{
+		{Name: "immobiliser", Commands: []string{"setdigout"}, IgnitionOff: true, Wait: time.Second * 5},
+	})
+	onPacket := packetHandler(hs, "", logger)
+	hub.onPacket = onPacket
+
+	cmd, err := commands.ParseCommand("setdigout 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type result struct {
+		msg *teltonika.Message
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		msg, err := hs.dispatcher.Send(context.Background(), "352093081452251", cmd)
+		done <- result{msg, err}
+	}()
+
+	// the command is held until an AVL record reports the ignition off
+	time.Sleep(time.Millisecond * 20)
+	onPacket("352093081452251", &teltonika.Packet{CodecID: teltonika.Codec8E, Data: []teltonika.Data{
+		{TimestampMs: uint64(time.Now().UnixMilli()), Elements: []teltonika.IOElement{{Id: ioelements.IgnitionID, Value: []byte{0}}}},
+	}})
+
+	select {
+	case res := <-done:
+		if res.err != nil || res.msg.Text != "DOUT1:1" {
+			t.Errorf("unexpected response %+v (%v)", res.msg, res.err)
+		}
+	case <-time.After(time.Second * 2):
+		t.Fatal("command is not released by the AVL packet")
+	}
+}
+
+func TestIsLoopback(t *testing.T) {
+	cases := map[string]bool{
+		"127.0.0.1:9001": true, "localhost:0": true, "[::1]:9001": true, "127.1.2.3:0": true,
+		"0.0.0.0:9001": false, ":9001": false, "[::]:9001": false, "192.168.1.10:9001": false, "127.0.0.1": false,
+	}
+	for address, expected := range cases {
+		if isLoopback(address) != expected {
+			t.Errorf("%s: expected %v", address, expected)
+		}
+	}
+}
+
+func TestRecordQueued(t *testing.T) {
+	audit, err := commands.OpenAuditLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = audit.Close() }()
+	hs := &HTTPServer{audit: audit, devices: &sync.Map{}}
+
+	hs.recordQueued(commands.QueuedCommand{Imei: "1", Command: "getver", Status: commands.StatusExpired})
+	// answered and failed commands were audited by the dispatcher
+	hs.recordQueued(commands.QueuedCommand{Imei: "1", Command: "getver", Status: commands.StatusAnswered})
+	hs.recordQueued(commands.QueuedCommand{Imei: "1", Command: "getver", Status: commands.StatusFailed, Error: "timeout"})
+
+	records, err := audit.Query(commands.AuditFilter{Imei: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Error != "expired in the offline queue" || records[0].SentAt != nil {
+		t.Errorf("unexpected records %+v", records)
+	}
+}
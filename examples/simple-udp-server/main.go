@@ -8,8 +8,10 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
@@ -18,9 +20,11 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alim-zanibekov/teltonika"
+	"github.com/alim-zanibekov/teltonika/commands"
 	"github.com/alim-zanibekov/teltonika/ioelements"
 )
 
@@ -36,15 +40,39 @@ type UDPServer struct {
 	logger      *Logger
 	OnPacket    func(imei string, pkt *teltonika.Packet)
 	workerCount int
+	// natTimeout how long the source address of the last packet is considered reachable,
+	// NAT mappings of mobile networks usually expire after 30 seconds - a few minutes of inactivity
+	natTimeout time.Duration
+	conn       *net.UDPConn
+	sessions   map[string]*UDPSession
+	sLock      sync.Mutex
+}
+
+// UDPSession last source address and packet id received from the tracker
+type UDPSession struct {
+	addr        *net.UDPAddr
+	packetId    uint16
+	avlPacketId uint8
+	firstSeen   time.Time
+	lastSeen    time.Time
+}
+
+type Client struct {
+	Imei     string    `json:"imei"`
+	Addr     string    `json:"addr"`
+	LastSeen time.Time `json:"lastSeen"`
 }
 
 //goland:noinspection GoUnusedExportedFunction
 func NewUDPServer(address string, workerCount int) *UDPServer {
-	return &UDPServer{address: address, workerCount: workerCount, logger: &Logger{log.Default(), log.Default()}}
+	return NewUDPServerLogger(address, workerCount, &Logger{log.Default(), log.Default()})
 }
 
 func NewUDPServerLogger(address string, workerCount int, logger *Logger) *UDPServer {
-	return &UDPServer{address: address, workerCount: workerCount, logger: logger}
+	return &UDPServer{
+		address: address, workerCount: workerCount, logger: logger,
+		natTimeout: time.Minute, sessions: map[string]*UDPSession{},
+	}
 }
 
 func (r *UDPServer) Run() error {
@@ -67,6 +95,10 @@ func (r *UDPServer) Run() error {
 		_ = udpConn.Close()
 	}()
 
+	r.sLock.Lock()
+	r.conn = udpConn
+	r.sLock.Unlock()
+
 	logger.Info.Printf("udp listening at %s", hostStr)
 
 	type job struct {
@@ -101,6 +133,72 @@ func (r *UDPServer) Run() error {
 	}
 }
 
+// SendPacket sends the packet to the source address of the last packet received from the tracker,
+// fails if the address is unknown or the NAT mapping is probably expired
+func (r *UDPServer) SendPacket(imei string, packet *teltonika.Packet) error {
+	r.sLock.Lock()
+	session, ok := r.sessions[imei]
+	if !ok || r.conn == nil {
+		r.sLock.Unlock()
+		return fmt.Errorf("client with imei '%s' not found", imei)
+	}
+	if age := time.Since(session.lastSeen); age > r.natTimeout {
+		r.sLock.Unlock()
+		return fmt.Errorf("client with imei '%s' is not reachable, last packet received %v ago", imei, age.Round(time.Second))
+	}
+	session.packetId++
+	packetId, avlPacketId, addr, conn := session.packetId, session.avlPacketId, session.addr, r.conn
+	r.sLock.Unlock()
+
+	buf, err := teltonika.EncodePacketUDP(imei, packetId, avlPacketId, packet)
+	if err != nil {
+		return err
+	}
+	if _, err = conn.WriteToUDP(buf, addr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListClients returns trackers with fresh NAT mapping
+func (r *UDPServer) ListClients() []*Client {
+	r.sLock.Lock()
+	defer r.sLock.Unlock()
+	clients := make([]*Client, 0, len(r.sessions))
+	for imei, session := range r.sessions {
+		if time.Since(session.lastSeen) <= r.natTimeout {
+			clients = append(clients, &Client{imei, session.addr.String(), session.lastSeen})
+		}
+	}
+	return clients
+}
+
+// IsReachable reports whether the tracker can be reached at the last source address
+func (r *UDPServer) IsReachable(imei string) bool {
+	r.sLock.Lock()
+	defer r.sLock.Unlock()
+	session, ok := r.sessions[imei]
+	return ok && time.Since(session.lastSeen) <= r.natTimeout
+}
+
+// updateSession stores the source address of the packet, returns true if the tracker was not reachable before
+func (r *UDPServer) updateSession(res *teltonika.DecodedUDP, addr *net.UDPAddr) bool {
+	r.sLock.Lock()
+	defer r.sLock.Unlock()
+	now := time.Now()
+	session, ok := r.sessions[res.Imei]
+	if !ok {
+		session = &UDPSession{firstSeen: now}
+		r.sessions[res.Imei] = session
+	}
+	reconnected := !ok || now.Sub(session.lastSeen) > r.natTimeout || session.addr.String() != addr.String()
+	session.addr = addr
+	session.packetId = res.PacketId
+	session.avlPacketId = res.AvlPacketId
+	session.lastSeen = now
+	return reconnected
+}
+
 func (r *UDPServer) handleConnection(conn *net.UDPConn, addr *net.UDPAddr, packet []byte) {
 	logger := r.logger
 	client := addr.String()
@@ -111,6 +209,10 @@ func (r *UDPServer) handleConnection(conn *net.UDPConn, addr *net.UDPAddr, packe
 		return
 	}
 
+	if r.updateSession(res, addr) {
+		logger.Info.Printf("[%s]: imei - %s", client, res.Imei)
+	}
+
 	if res.Response != nil {
 		if _, err = conn.WriteToUDP(res.Response, addr); err != nil {
 			logger.Error.Printf("[%s]: error writing response (%v)", client, err)
@@ -142,11 +244,105 @@ func (r *UDPServer) handleConnection(conn *net.UDPConn, addr *net.UDPAddr, packe
 	}
 }
 
+type HTTPServer struct {
+	address    string
+	udp        *UDPServer
+	dispatcher *commands.Dispatcher
+	users      map[string]string // basic auth user name -> password used to verify requesters
+	logger     *Logger
+}
+
+// lateResponseWindow how long a late answer to a timed out command is expected, see commands.DispatcherConfig
+const lateResponseWindow = time.Second * 30
+
+func NewHTTPServerLogger(address string, udp *UDPServer, logger *Logger) *HTTPServer {
+	dispatcher := commands.NewDispatcher(udp, &commands.DispatcherConfig{LateResponseWindow: lateResponseWindow})
+	return &HTTPServer{address: address, udp: udp, dispatcher: dispatcher, logger: logger}
+}
+
+func (hs *HTTPServer) Run() error {
+	handler := http.NewServeMux()
+
+	handler.HandleFunc("/cmd", hs.handleCmd)
+
+	handler.HandleFunc("/list-clients", hs.listClients)
+
+	hs.logger.Info.Println("http server listening at " + hs.address)
+
+	err := http.ListenAndServe(hs.address, handler)
+	if err != nil {
+		return fmt.Errorf("http listen error (%v)", err)
+	}
+	return nil
+}
+
+func (hs *HTTPServer) listClients(w http.ResponseWriter, _ *http.Request) {
+	hs.writeJson(w, http.StatusOK, hs.udp.ListClients())
+}
+
+// handleCmd sends the command to the tracker and waits for the response, the command API is the same as
+// in the TCP server, but the tracker must have sent a packet within the NAT timeout
+func (hs *HTTPServer) handleCmd(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	imei := params.Get("imei")
+	cmd, err := commands.ReadCommand(r)
+	if err != nil {
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	config := &commands.SendConfig{Codec14: params.Get("codec") == "14", Requester: commands.Requester(r, hs.users)}
+	if timeout := params.Get("timeout"); timeout != "" {
+		if config.Timeout, err = time.ParseDuration(timeout); err != nil {
+			hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": fmt.Sprintf("invalid timeout (%v)", err)})
+			return
+		}
+	}
+
+	if !hs.udp.IsReachable(imei) {
+		hs.writeJson(w, http.StatusServiceUnavailable, map[string]interface{}{"error": fmt.Sprintf("client with imei '%s' is not reachable", imei)})
+		return
+	}
+
+	hs.logger.Info.Printf("command '%s' queued for '%s'", cmd, imei)
+	msg, err := hs.dispatcher.Send(r.Context(), imei, cmd, config)
+	switch {
+	case errors.Is(err, commands.ErrDisconnected):
+		hs.writeJson(w, http.StatusServiceUnavailable, map[string]interface{}{"error": err.Error()})
+	case errors.Is(err, commands.ErrTimeout):
+		hs.writeJson(w, http.StatusGatewayTimeout, map[string]interface{}{"error": err.Error()})
+	case errors.Is(err, commands.ErrQueueFull):
+		hs.writeJson(w, http.StatusTooManyRequests, map[string]interface{}{"error": err.Error()})
+	case errors.Is(err, context.Canceled):
+		hs.logger.Info.Printf("command '%s' to '%s' canceled by the client", cmd, imei)
+	case err != nil && !errors.Is(err, commands.ErrNotExecuted):
+		hs.logger.Error.Printf("send packet error (%v)", err)
+		hs.writeJson(w, http.StatusBadRequest, map[string]interface{}{"error": err.Error()})
+	default:
+		hs.writeJson(w, http.StatusOK, commands.CommandResult(cmd, msg, params.Get("format")))
+	}
+}
+
+func (hs *HTTPServer) writeJson(w http.ResponseWriter, status int, value interface{}) {
+	body, _ := json.Marshal(value)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		hs.logger.Error.Printf("http write error (%v)", err)
+	}
+}
+
 func main() {
 	var address string
+	var httpAddress string
 	var outHook string
+	var natTimeout time.Duration
+	var usersFile string
 	flag.StringVar(&address, "address", "0.0.0.0:8080", "server address")
+	flag.StringVar(&httpAddress, "http", "0.0.0.0:8081", "http server address")
 	flag.StringVar(&outHook, "hook", "", "output hook\nfor example: http://localhost:8080/push")
+	flag.DurationVar(&natTimeout, "nat-timeout", time.Minute, "how long the tracker address is reachable after the last packet")
+	flag.StringVar(&usersFile, "users", "", "json file with basic auth users ({\"name\": \"password\"}) used to verify the requester identity")
 	flag.Parse()
 
 	logger := &Logger{
@@ -155,14 +351,42 @@ func main() {
 	}
 
 	server := NewUDPServerLogger(address, 20, logger)
+	server.natTimeout = natTimeout
+	serverHttp := NewHTTPServerLogger(httpAddress, server, logger)
+	if usersFile != "" {
+		data, err := os.ReadFile(usersFile)
+		if err != nil {
+			panic(err)
+		}
+		if err = json.Unmarshal(data, &serverHttp.users); err != nil {
+			panic(fmt.Errorf("%s parse error (%v)", usersFile, err))
+		}
+	}
+
+	// Codec 13 and Codec 15 messages are sent by the tracker on its own, they are not command responses
+	incoming := &commands.UnsolicitedHandler{
+		Sink: func(message *commands.DeviceMessage) {
+			logger.Info.Printf("[%s]: device message (codec %d, type 0x%X, %s): %s",
+				message.Imei, message.Codec, message.Type, message.Timestamp.Format(time.RFC3339), message.Text)
+		},
+		OnError: func(err error) {
+			logger.Error.Printf("device message dropped (%v)", err)
+		},
+	}
 
 	server.OnPacket = func(imei string, pkt *teltonika.Packet) {
+		if len(pkt.Messages) > 0 && !incoming.HandlePacket(imei, pkt) && !serverHttp.dispatcher.HandlePacket(imei, pkt) {
+			logger.Info.Printf("[%s]: unexpected response: %s", imei, pkt.Messages[0].Text)
+		}
 		if pkt.Data != nil && outHook != "" {
 			go hookSend(outHook, imei, pkt, logger)
 		}
 	}
 
-	panic(server.Run())
+	go func() {
+		panic(server.Run())
+	}()
+	panic(serverHttp.Run())
 }
 
 func buildJsonPacket(imei string, pkt *teltonika.Packet) []byte {
@@ -0,0 +1,152 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package ioelements
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+// ErrElementNotPresent returned by typed accessors when the record has no I/O element with the requested id
+var ErrElementNotPresent = errors.New("io element is not present")
+
+// Element is an accessor for an I/O element whose value type differs between models,
+// see `ioelements_ids.go` for the generated accessors
+type Element struct {
+	Id uint16
+}
+
+// BoolElement is an accessor for an I/O element decoded as bool
+type BoolElement struct {
+	Id uint16
+}
+
+// UintElement is an accessor for an I/O element decoded as uint64
+type UintElement struct {
+	Id uint16
+}
+
+// IntElement is an accessor for an I/O element decoded as int64
+type IntElement struct {
+	Id uint16
+}
+
+// FloatElement is an accessor for an I/O element decoded as float64 (the multiplier is applied)
+type FloatElement struct {
+	Id uint16
+}
+
+// StringElement is an accessor for an I/O element decoded as string (HEX or ASCII)
+type StringElement struct {
+	Id uint16
+}
+
+// Get finds the I/O element in the elements list and decodes it for the given model
+func (r Element) Get(decoder *Decoder, modelName string, elements []teltonika.IOElement) (interface{}, error) {
+	it, err := findAndDecode(decoder, modelName, r.Id, elements)
+	if err != nil {
+		return nil, err
+	}
+	return it.Value, nil
+}
+
+// Get finds the I/O element in the elements list and decodes it for the given model
+func (r BoolElement) Get(decoder *Decoder, modelName string, elements []teltonika.IOElement) (bool, error) {
+	it, err := findAndDecode(decoder, modelName, r.Id, elements)
+	if err != nil {
+		return false, err
+	}
+	switch v := it.Value.(type) {
+	case bool:
+		return v, nil
+	case uint64:
+		return v != 0, nil
+	case int64:
+		return v != 0, nil
+	}
+	return false, valueTypeError(it, "bool")
+}
+
+// Get finds the I/O element in the elements list and decodes it for the given model
+func (r UintElement) Get(decoder *Decoder, modelName string, elements []teltonika.IOElement) (uint64, error) {
+	it, err := findAndDecode(decoder, modelName, r.Id, elements)
+	if err != nil {
+		return 0, err
+	}
+	switch v := it.Value.(type) {
+	case uint64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, valueTypeError(it, "uint64")
+}
+
+// Get finds the I/O element in the elements list and decodes it for the given model
+func (r IntElement) Get(decoder *Decoder, modelName string, elements []teltonika.IOElement) (int64, error) {
+	it, err := findAndDecode(decoder, modelName, r.Id, elements)
+	if err != nil {
+		return 0, err
+	}
+	switch v := it.Value.(type) {
+	case int64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, valueTypeError(it, "int64")
+}
+
+// Get finds the I/O element in the elements list and decodes it for the given model
+func (r FloatElement) Get(decoder *Decoder, modelName string, elements []teltonika.IOElement) (float64, error) {
+	it, err := findAndDecode(decoder, modelName, r.Id, elements)
+	if err != nil {
+		return 0, err
+	}
+	switch v := it.Value.(type) {
+	case float64:
+		return v, nil
+	case uint64:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	}
+	return 0, valueTypeError(it, "float64")
+}
+
+// Get finds the I/O element in the elements list and decodes it for the given model
+func (r StringElement) Get(decoder *Decoder, modelName string, elements []teltonika.IOElement) (string, error) {
+	it, err := findAndDecode(decoder, modelName, r.Id, elements)
+	if err != nil {
+		return "", err
+	}
+	if v, ok := it.Value.(string); ok {
+		return v, nil
+	}
+	return "", valueTypeError(it, "string")
+}
+
+func findAndDecode(decoder *Decoder, modelName string, id uint16, elements []teltonika.IOElement) (*IOElement, error) {
+	for _, element := range elements {
+		if element.Id == id {
+			return decoder.Decode(modelName, id, element.Value)
+		}
+	}
+	return nil, fmt.Errorf("%w (id %v)", ErrElementNotPresent, id)
+}
+
+func valueTypeError(it *IOElement, expected string) error {
+	return fmt.Errorf("io element with id %v decoded as %T, expected %s", it.Id, it.Value, expected)
+}
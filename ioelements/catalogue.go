@@ -0,0 +1,175 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package ioelements
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ListModels returns sorted names of all models known to the decoder
+func (r *Decoder) ListModels() []string {
+	res := make([]string, 0, len(r.supportedModels))
+	for model := range r.supportedModels {
+		res = append(res, model)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ListGroups returns sorted names of all I/O element groups (e.g. "OBD elements", "Bluetooth Low Energy")
+func (r *Decoder) ListGroups() []string {
+	seen := map[string]bool{}
+	res := make([]string, 0)
+	for _, e := range r.definitions {
+		for _, group := range e.Groups {
+			if group != "" && !seen[group] {
+				seen[group] = true
+				res = append(res, group)
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ElementsForModel returns all I/O element definitions supported by the model
+// Passing '*' as the model name returns all definitions
+func (r *Decoder) ElementsForModel(modelName string) ([]*IOElementDefinition, error) {
+	return r.filter(modelName, func(*IOElementDefinition) bool { return true })
+}
+
+// ElementsInGroup returns I/O element definitions supported by the model which belong to the group.
+// Group name is compared case-insensitively, either with the whole group name or with one of its words,
+// so "obd" matches both "OBD elements" and "OBD OEM elements"
+// If you don't know the model name, you can skip the model name check by passing '*' as the model name
+func (r *Decoder) ElementsInGroup(modelName string, group string) ([]*IOElementDefinition, error) {
+	query := normalizeName(group)
+	return r.filter(modelName, func(e *IOElementDefinition) bool {
+		for _, it := range e.Groups {
+			name := normalizeName(it)
+			if name == query {
+				return true
+			}
+			for _, word := range strings.Fields(name) {
+				if word == query {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// FindByName returns I/O element definitions supported by the model which name matches the query,
+// the best matches come first. Matching is case-insensitive and ignores punctuation, a name matches if it
+// equals the query, starts with it, contains it, contains all query words or all query characters in order
+// If you don't know the model name, you can skip the model name check by passing '*' as the model name
+func (r *Decoder) FindByName(modelName string, query string) ([]*IOElementDefinition, error) {
+	query = normalizeName(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty query")
+	}
+
+	scores := map[*IOElementDefinition]int{}
+	res, err := r.filter(modelName, func(e *IOElementDefinition) bool {
+		score, ok := matchName(normalizeName(e.Name), query)
+		if ok {
+			scores[e] = score
+		}
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if scores[res[i]] != scores[res[j]] {
+			return scores[res[i]] < scores[res[j]]
+		}
+		if len(res[i].Name) != len(res[j].Name) {
+			return len(res[i].Name) < len(res[j].Name)
+		}
+		return res[i].Id < res[j].Id
+	})
+	return res, nil
+}
+
+func (r *Decoder) filter(modelName string, check func(e *IOElementDefinition) bool) ([]*IOElementDefinition, error) {
+	if modelName != "*" {
+		modelName = r.ResolveModel(modelName)
+	}
+	if modelName != "*" && !r.supportedModels[modelName] {
+		return nil, fmt.Errorf("model '%s' is not supported", modelName)
+	}
+
+	res := make([]*IOElementDefinition, 0)
+	for i := range r.definitions {
+		e := r.definitions[i]
+		if modelName != "*" && !containsString(e.SupportedModels, modelName) {
+			continue
+		}
+		if check(&e) {
+			res = append(res, &e)
+		}
+	}
+	return res, nil
+}
+
+// matchName returns match score of the normalized name (lower is better)
+func matchName(name string, query string) (int, bool) {
+	if name == query {
+		return 0, true
+	}
+	if strings.HasPrefix(name, query) {
+		return 1, true
+	}
+	if strings.Contains(name, query) {
+		return 2, true
+	}
+
+	allWords := true
+	for _, word := range strings.Fields(query) {
+		if !strings.Contains(name, word) {
+			allWords = false
+			break
+		}
+	}
+	if allWords {
+		return 3, true
+	}
+
+	chars := []rune(strings.ReplaceAll(query, " ", ""))
+	pos := 0
+	for _, c := range name {
+		if pos < len(chars) && chars[pos] == c {
+			pos++
+		}
+	}
+	if pos == len(chars) {
+		return 4, true
+	}
+	return 0, false
+}
+
+// normalizeName converts name to lower case and replaces punctuation with single spaces
+func normalizeName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}), " ")
+}
+
+func containsString(list []string, value string) bool {
+	for _, it := range list {
+		if it == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package ioelements
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+var defaultDecoder *Decoder
+var defaultDecoderOnce sync.Once
+
+// DefaultDecoder returns a decoder with I/O Element definitions embedded in the package (`ioelements_dump.json.gz`),
+// the definitions are parsed on the first call.
+// If the package is built with the `ioelements_nodata` build tag, the decoder has no definitions
+func DefaultDecoder() *Decoder {
+	defaultDecoderOnce.Do(func() {
+		defaultDecoder = NewDecoder(defaultDefinitions())
+	})
+	return defaultDecoder
+}
+
+// ReadDefinitions parses I/O element definitions written by the generator (`--gen-format embed`),
+// a json array of definitions, optionally gzip compressed
+func ReadDefinitions(data []byte) ([]IOElementDefinition, error) {
+	var reader io.Reader = bytes.NewReader(data)
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read definitions (%v)", err)
+		}
+		defer func() { _ = gz.Close() }()
+		reader = gz
+	}
+
+	var res []IOElementDefinition
+	if err := json.NewDecoder(reader).Decode(&res); err != nil {
+		return nil, fmt.Errorf("unable to parse definitions (%v)", err)
+	}
+	return res, nil
+}
+
+func mustReadDefinitions(data []byte) []IOElementDefinition {
+	res, err := ReadDefinitions(data)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
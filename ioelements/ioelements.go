@@ -10,6 +10,11 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/alim-zanibekov/teltonika"
 )
 
 type ElementType uint8
@@ -36,17 +41,46 @@ type IOElementDefinition struct {
 }
 
 type IOElement struct {
-	Id         uint16               `json:"id,omitempty"`
-	Value      interface{}          `json:"value,omitempty"`
-	Definition *IOElementDefinition `json:"definition,omitempty"`
+	Id          uint16               `json:"id,omitempty"`
+	Value       interface{}          `json:"value,omitempty"`
+	Definition  *IOElementDefinition `json:"definition,omitempty"`
+	Diagnostics []Diagnostic         `json:"diagnostics,omitempty"` // inconsistencies between the value and the definition
+}
+
+type DecodeMode uint8
+
+const (
+	Lenient DecodeMode = iota // Diagnostics are attached to the decoded IOElement
+	Strict                    // Diagnostics are returned as an error
+)
+
+// DecodeConfig optional configuration that can be passed in Decode and DecodeByDefinition functions (last param).
+// By default, used - DecodeConfig { Mode: Lenient }
+type DecodeConfig struct {
+	Mode DecodeMode
+}
+
+type DiagnosticKind uint8
+
+const (
+	OutOfRange    DiagnosticKind = iota // Raw value is outside the documented Min/Max range
+	WidthMismatch                       // Value size is not equal to NumBytes
+)
+
+type Diagnostic struct {
+	Kind    DiagnosticKind `json:"kind"`
+	Message string         `json:"message"`
 }
 
 type Decoder struct {
 	definitions     []IOElementDefinition
 	supportedModels map[string]bool
+	modelConfig     *ModelConfig
 }
 
-var defaultDecoder = &Decoder{ioElementDefinitions, supportedModels}
+var defaultDecodeConfig = &DecodeConfig{
+	Mode: Lenient,
+}
 
 func (r *IOElement) String() string {
 	switch r.Value.(type) {
@@ -65,21 +99,37 @@ func NewDecoder(definitions []IOElementDefinition) *Decoder {
 			allSupportedModels[model] = true
 		}
 	}
-	return &Decoder{definitions, allSupportedModels}
-}
-
-// DefaultDecoder returns a decoder with I/O Element definitions represented in `ioelements_dump.go` file
-func DefaultDecoder() *Decoder {
-	return defaultDecoder
+	return &Decoder{definitions: definitions, supportedModels: allSupportedModels}
 }
 
 // GetElementInfo returns full description of I/O Element by its id and model name
 // If you don't know the model name, you can skip the model name check by passing '*' as the model name
+// Model names are resolved according to the decoder ModelConfig (see WithModelConfig):
+// exact model (after alias resolution) -> family members in order -> any model if Wildcard is enabled
 func (r *Decoder) GetElementInfo(modelName string, id uint16) (*IOElementDefinition, error) {
-	if modelName != "*" && !r.supportedModels[modelName] {
+	if modelName == "*" {
+		return r.findDefinition(id, "*")
+	}
+
+	models := r.resolveModels(modelName)
+	wildcard := r.modelConfig != nil && r.modelConfig.Wildcard
+	if len(models) == 0 && !wildcard {
 		return nil, fmt.Errorf("model '%s' is not supported", modelName)
 	}
 
+	for _, model := range models {
+		if def, err := r.findDefinition(id, model); err == nil {
+			return def, nil
+		}
+	}
+	if wildcard {
+		return r.findDefinition(id, "*")
+	}
+
+	return nil, fmt.Errorf("element with id %v not found", id)
+}
+
+func (r *Decoder) findDefinition(id uint16, modelName string) (*IOElementDefinition, error) {
 	for _, e := range r.definitions {
 		if e.Id != id {
 			continue
@@ -101,55 +151,65 @@ func (r *Decoder) GetElementInfo(modelName string, id uint16) (*IOElementDefinit
 
 // Decode decodes an I/O Element by model name and id (result can be represented in numan-readable format)
 // If you don't know the model name, you can skip the model name check by passing '*' as the model name
-func (r *Decoder) Decode(modelName string, id uint16, buffer []byte) (*IOElement, error) {
+func (r *Decoder) Decode(modelName string, id uint16, buffer []byte, config ...*DecodeConfig) (*IOElement, error) {
 	def, err := r.GetElementInfo(modelName, id)
 	if err != nil {
 		return nil, err
 	}
-	return r.DecodeByDefinition(def, buffer)
+	return r.DecodeByDefinition(def, buffer, config...)
 }
 
 // DecodeByDefinition decodes an I/O Element according to a given definition
-func (r *Decoder) DecodeByDefinition(def *IOElementDefinition, buffer []byte) (*IOElement, error) {
+// Numeric values of any width from 1 to 8 bytes are supported (NX elements may have 3 or 6 bytes).
+// Values outside the documented Min/Max range and buffers which size differs from NumBytes are reported
+// in IOElement.Diagnostics, or as an error if DecodeConfig.Mode is Strict
+func (r *Decoder) DecodeByDefinition(def *IOElementDefinition, buffer []byte, config ...*DecodeConfig) (*IOElement, error) {
+	if len(config) > 1 {
+		return nil, fmt.Errorf("too many arguments specified")
+	}
+	cfg := defaultDecodeConfig
+	if len(config) == 1 && config[0] != nil {
+		cfg = config[0]
+	}
+
 	var res interface{}
+	var diagnostics []Diagnostic
 
 	size := len(buffer)
-	if size == 1 && def.Min == 0 && def.Max == 1 && def.Type == IOElementUnsigned {
-		res = buffer[0] == 1
-	} else if (size == 1 || size == 2 || size == 4 || size == 8) && (def.Type == IOElementUnsigned || def.Type == IOElementSigned) {
+	isNumeric := def.Type == IOElementUnsigned || def.Type == IOElementSigned
+	if isNumeric && size >= 1 && size <= 8 {
+		var v uint64
+		for _, b := range buffer {
+			v = v<<8 | uint64(b)
+		}
+
+		var raw float64
 		if def.Type == IOElementUnsigned {
-			var v uint64
-			if size == 1 {
-				v = uint64(buffer[0])
-			} else if size == 2 {
-				v = uint64(binary.BigEndian.Uint16(buffer))
-			} else if size == 4 {
-				v = uint64(binary.BigEndian.Uint32(buffer))
-			} else {
-				v = binary.BigEndian.Uint64(buffer)
-			}
-			if def.Multiplier != 1.0 {
-				res = float64(v) * def.Multiplier
+			raw = float64(v)
+			if size == 1 && def.Min == 0 && def.Max == 1 {
+				res = v == 1
+			} else if hasMultiplier(def) {
+				res = raw * def.Multiplier
 			} else {
 				res = v
 			}
-		} else if def.Type == IOElementSigned {
-			var v int64
-			if size == 1 {
-				v = int64(int8(buffer[0]))
-			} else if size == 2 {
-				v = int64(int16(binary.BigEndian.Uint16(buffer)))
-			} else if size == 4 {
-				v = int64(int32(binary.BigEndian.Uint32(buffer)))
+		} else {
+			shift := uint(64 - size*8)
+			signed := int64(v<<shift) >> shift
+			raw = float64(signed)
+			if hasMultiplier(def) {
+				res = raw * def.Multiplier
 			} else {
-				v = int64(binary.BigEndian.Uint64(buffer))
-			}
-			if def.Multiplier != 1.0 {
-				res = float64(v) * def.Multiplier
-			} else {
-				res = v
+				res = signed
 			}
 		}
+
+		if def.Min < def.Max && (raw < def.Min || raw > def.Max) {
+			diagnostics = append(diagnostics, Diagnostic{
+				Kind:    OutOfRange,
+				Message: fmt.Sprintf("raw value %v is out of range [%v, %v]", raw, def.Min, def.Max),
+			})
+		}
 	} else if def.Type == IOElementHEX {
 		res = hex.EncodeToString(buffer)
 	} else if def.Type == IOElementASCII {
@@ -160,7 +220,189 @@ func (r *Decoder) DecodeByDefinition(def *IOElementDefinition, buffer []byte) (*
 		return nil, fmt.Errorf("unable to proceed io element with id %v for buffer '%s'", def.Id, hex.EncodeToString(buffer))
 	}
 
+	if def.NumBytes > 0 && size != def.NumBytes {
+		diagnostics = append(diagnostics, Diagnostic{
+			Kind:    WidthMismatch,
+			Message: fmt.Sprintf("value has %v byte(s), definition specifies %v", size, def.NumBytes),
+		})
+	}
+
+	if cfg.Mode == Strict && len(diagnostics) > 0 {
+		messages := make([]string, len(diagnostics))
+		for i, it := range diagnostics {
+			messages[i] = it.Message
+		}
+		return nil, fmt.Errorf("io element with id %v: %s", def.Id, strings.Join(messages, "; "))
+	}
+
 	return &IOElement{
-		def.Id, res, def,
+		Id: def.Id, Value: res, Definition: def, Diagnostics: diagnostics,
 	}, nil
 }
+
+// Encode encodes a human-readable value into I/O Element bytes according to a given definition,
+// it is the inverse of DecodeByDefinition.
+// Signed and Unsigned elements accept bool, integer and float values, the value is divided by the multiplier
+// and rounded to the nearest integer, the result must fit into NumBytes and into the Min/Max range (if specified).
+// HEX elements accept a hex string or []byte, ASCII elements accept a string or []byte
+func Encode(def *IOElementDefinition, value interface{}) ([]byte, error) {
+	switch def.Type {
+	case IOElementUnsigned, IOElementSigned:
+		return encodeNumeric(def, value)
+	case IOElementHEX:
+		var buffer []byte
+		switch v := value.(type) {
+		case string:
+			var err error
+			if buffer, err = hex.DecodeString(v); err != nil {
+				return nil, fmt.Errorf("io element with id %v: invalid hex value '%s' (%v)", def.Id, v, err)
+			}
+		case []byte:
+			buffer = v
+		default:
+			return nil, fmt.Errorf("io element with id %v: unable to encode %T as HEX", def.Id, value)
+		}
+		return checkBufferSize(def, buffer)
+	case IOElementASCII:
+		switch v := value.(type) {
+		case string:
+			return checkBufferSize(def, []byte(v))
+		case []byte:
+			return checkBufferSize(def, v)
+		default:
+			return nil, fmt.Errorf("io element with id %v: unable to encode %T as ASCII", def.Id, value)
+		}
+	}
+	return nil, fmt.Errorf("io element with id %v has unknown type %v", def.Id, def.Type)
+}
+
+// EncodeElement encodes a human-readable value of an I/O Element by model name and id
+// If you don't know the model name, you can skip the model name check by passing '*' as the model name
+func (r *Decoder) EncodeElement(modelName string, id uint16, value interface{}) (teltonika.IOElement, error) {
+	def, err := r.GetElementInfo(modelName, id)
+	if err != nil {
+		return teltonika.IOElement{}, err
+	}
+	buffer, err := Encode(def, value)
+	if err != nil {
+		return teltonika.IOElement{}, err
+	}
+	return teltonika.IOElement{Id: id, Value: buffer}, nil
+}
+
+func encodeNumeric(def *IOElementDefinition, value interface{}) ([]byte, error) {
+	size := def.NumBytes
+	if size < 1 || size > 8 {
+		return nil, fmt.Errorf("io element with id %v: unable to encode numeric value with size %v", def.Id, size)
+	}
+
+	raw := new(big.Int)
+	switch v := value.(type) {
+	case bool:
+		if v {
+			raw.SetInt64(1)
+		}
+	case int:
+		raw.SetInt64(int64(v))
+	case int8:
+		raw.SetInt64(int64(v))
+	case int16:
+		raw.SetInt64(int64(v))
+	case int32:
+		raw.SetInt64(int64(v))
+	case int64:
+		raw.SetInt64(v)
+	case uint:
+		raw.SetUint64(uint64(v))
+	case uint8:
+		raw.SetUint64(uint64(v))
+	case uint16:
+		raw.SetUint64(uint64(v))
+	case uint32:
+		raw.SetUint64(uint64(v))
+	case uint64:
+		raw.SetUint64(v)
+	case float32:
+		if err := setRawFloat(def, raw, float64(v)); err != nil {
+			return nil, err
+		}
+	case float64:
+		if err := setRawFloat(def, raw, v); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("io element with id %v: unable to encode %T as number", def.Id, value)
+	}
+
+	switch value.(type) {
+	case bool, float32, float64:
+	default:
+		if hasMultiplier(def) {
+			if err := setRawFloat(def, raw, float64FromInt(raw)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if def.Min < def.Max {
+		if f := float64FromInt(raw); f < def.Min || f > def.Max {
+			return nil, fmt.Errorf("io element with id %v: raw value %v is out of range [%v, %v]", def.Id, raw, def.Min, def.Max)
+		}
+	}
+
+	bits := uint(size * 8)
+	var lo, hi *big.Int
+	if def.Type == IOElementUnsigned {
+		lo = big.NewInt(0)
+		hi = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+	} else {
+		hi = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits-1), big.NewInt(1))
+		lo = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+	}
+	if raw.Cmp(lo) < 0 || raw.Cmp(hi) > 0 {
+		return nil, fmt.Errorf("io element with id %v: raw value %v does not fit into %v byte(s)", def.Id, raw, size)
+	}
+
+	var bits64 uint64
+	if raw.Sign() < 0 {
+		bits64 = uint64(raw.Int64())
+	} else {
+		bits64 = raw.Uint64()
+	}
+
+	buffer := make([]byte, 8)
+	binary.BigEndian.PutUint64(buffer, bits64)
+	return buffer[8-size:], nil
+}
+
+func setRawFloat(def *IOElementDefinition, raw *big.Int, value float64) error {
+	if hasMultiplier(def) {
+		value = value / def.Multiplier
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("io element with id %v: unable to encode %v", def.Id, value)
+	}
+	big.NewFloat(math.Round(value)).Int(raw)
+	return nil
+}
+
+// hasMultiplier reports whether the raw value is scaled, used by both DecodeByDefinition and Encode,
+// a zero multiplier is treated as missing
+func hasMultiplier(def *IOElementDefinition) bool {
+	return def.Multiplier != 1.0 && def.Multiplier != 0
+}
+
+func float64FromInt(v *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
+
+func checkBufferSize(def *IOElementDefinition, buffer []byte) ([]byte, error) {
+	if len(buffer) == 0 {
+		return nil, fmt.Errorf("io element with id %v: empty value", def.Id)
+	}
+	if def.NumBytes > 0 && len(buffer) != def.NumBytes {
+		return nil, fmt.Errorf("io element with id %v: expected %v byte(s), got %v", def.Id, def.NumBytes, len(buffer))
+	}
+	return buffer, nil
+}
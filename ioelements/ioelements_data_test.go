@@ -0,0 +1,275 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+//go:build !ioelements_nodata
+
+package ioelements
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/alim-zanibekov/teltonika"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	decoder := DefaultDecoder()
+	cases := []struct {
+		model    string
+		id       uint16
+		value    interface{}
+		expected string
+	}{
+		{"FMB920", 239, true, "01"},    // Ignition
+		{"FMB920", 66, 12.345, "3039"}, // External Voltage, V (multiplier 0.001)
+		{"FMB920", 16, uint64(123456), "0001e240"},
+		{"FMB920", 17, int64(-1000), "fc18"}, // Axis X, mG
+		{"FMB920", 11, uint64(0x0102030405060708), "0102030405060708"},
+		{"FMC650", 12935, "0a", "0a"}, // COM2 DSM error code (HEX)
+	}
+
+	for _, c := range cases {
+		element, err := decoder.EncodeElement(c.model, c.id, c.value)
+		if err != nil {
+			t.Fatalf("[%d] %v", c.id, err)
+		}
+		if element.Id != c.id {
+			t.Errorf("[%d] encoded id %d", c.id, element.Id)
+		}
+		if encoded := hex.EncodeToString(element.Value); encoded != c.expected {
+			t.Errorf("[%d] encoded: %s, expected: %s", c.id, encoded, c.expected)
+		}
+
+		decoded, err := decoder.Decode(c.model, c.id, element.Value)
+		if err != nil {
+			t.Fatalf("[%d] %v", c.id, err)
+		}
+		if f, ok := c.value.(float64); ok {
+			if math.Abs(decoded.Value.(float64)-f) > 1e-9 {
+				t.Errorf("[%d] decoded: %v, expected: %v", c.id, decoded.Value, c.value)
+			}
+		} else if decoded.Value != c.value {
+			t.Errorf("[%d] decoded: %v, expected: %v", c.id, decoded.Value, c.value)
+		}
+	}
+}
+
+func TestEncodeAllDefinitionsRoundTrip(t *testing.T) {
+	decoder := DefaultDecoder()
+	for i := range decoder.definitions {
+		def := &decoder.definitions[i]
+		if def.Type != IOElementUnsigned && def.Type != IOElementSigned || def.Min >= def.Max {
+			continue
+		}
+		if def.NumBytes < 1 || def.NumBytes > 8 {
+			continue
+		}
+		for _, raw := range []float64{def.Min, def.Max} {
+			value := raw
+			if hasMultiplier(def) {
+				value = raw * def.Multiplier
+			}
+			buffer, err := Encode(def, value)
+			if err != nil {
+				// Min/Max from the wiki may not fit into NumBytes
+				continue
+			}
+			decoded, err := decoder.DecodeByDefinition(def, buffer)
+			if err != nil {
+				t.Fatalf("[%d] %v", def.Id, err)
+			}
+			again, err := Encode(def, decoded.Value)
+			if err != nil {
+				t.Fatalf("[%d] %v", def.Id, err)
+			}
+			if !bytes.Equal(buffer, again) {
+				t.Errorf("[%d] %s != %s", def.Id, hex.EncodeToString(buffer), hex.EncodeToString(again))
+			}
+		}
+	}
+}
+
+func TestTypedAccessors(t *testing.T) {
+	decoder := DefaultDecoder()
+	elements := []teltonika.IOElement{
+		{Id: IgnitionID, Value: []byte{1}},
+		{Id: ExternalVoltageID, Value: []byte{0x30, 0x39}},
+		{Id: TotalOdometerID, Value: []byte{0x00, 0x01, 0xe2, 0x40}},
+	}
+
+	ignition, err := Ignition.Get(decoder, "FMB920", elements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ignition {
+		t.Error("ignition: expected true")
+	}
+
+	voltage, err := ExternalVoltage.Get(decoder, "FMB920", elements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(voltage-12.345) > 1e-9 {
+		t.Errorf("external voltage: %v, expected 12.345", voltage)
+	}
+	// FMB640 reports External Voltage in mV without a multiplier, the value is not scaled
+	if voltage, err = ExternalVoltage.Get(decoder, "FMB640", elements); err != nil || voltage != 12345 {
+		t.Errorf("FMB640 external voltage: %v (%v), expected 12345", voltage, err)
+	}
+
+	odometer, err := TotalOdometer.Get(decoder, "FMB920", elements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if odometer != 123456 {
+		t.Errorf("total odometer: %v, expected 123456", odometer)
+	}
+
+	if _, err = Speed.Get(decoder, "FMB920", elements); !errors.Is(err, ErrElementNotPresent) {
+		t.Errorf("expected ErrElementNotPresent, got %v", err)
+	}
+}
+
+func TestCatalogueQueries(t *testing.T) {
+	decoder := DefaultDecoder()
+
+	models := decoder.ListModels()
+	if len(models) == 0 || !containsString(models, "FMB920") {
+		t.Fatalf("FMB920 not found in models list %v", models)
+	}
+	if len(decoder.ListGroups()) == 0 {
+		t.Error("empty groups list")
+	}
+
+	elements, err := decoder.ElementsForModel("FMB920")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range elements {
+		if !containsString(e.SupportedModels, "FMB920") {
+			t.Errorf("element %d is not supported by FMB920", e.Id)
+		}
+	}
+	if _, err = decoder.ElementsForModel("XXX000"); err == nil {
+		t.Error("unsupported model accepted")
+	}
+
+	found, err := decoder.FindByName("FMB920", "ignition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) == 0 || found[0].Id != IgnitionID {
+		t.Errorf("expected Ignition to be the best match, got %v", found)
+	}
+
+	found, err = decoder.FindByName("*", "ext volt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) == 0 || found[0].Id != ExternalVoltageID {
+		t.Errorf("expected External Voltage to be the best match, got %v", found)
+	}
+
+	obd, err := decoder.ElementsInGroup("*", "obd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(obd) == 0 {
+		t.Error("no OBD elements found")
+	}
+	for _, e := range obd {
+		if !strings.Contains(strings.ToLower(strings.Join(e.Groups, ",")), "obd") {
+			t.Errorf("element %d is not in OBD group: %v", e.Id, e.Groups)
+		}
+	}
+}
+
+func TestModelAliasesAndFamilies(t *testing.T) {
+	decoder := DefaultDecoder()
+	if _, err := decoder.GetElementInfo("FMB920-XX", AxisXID); err == nil {
+		t.Fatal("unknown model accepted without model config")
+	}
+
+	configured := decoder.WithModelConfig(&ModelConfig{
+		Aliases: map[string]string{"FMB920-*": "FMB920"},
+		Families: map[string][]string{
+			"trackers": {"TMT250", "FMB920", "MyTracker"},
+			"fm":       {"FMB920", "FMC650"},
+		},
+	})
+
+	def, err := configured.GetElementInfo("FMB920-XX", AxisXID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Units != "mG" {
+		t.Errorf("alias: expected FMB920 definition of Axis X, got %+v", def)
+	}
+
+	// FMB920 has no definition, the next "fm" family member is used
+	def, err = configured.GetElementInfo("FMB920", 12935)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !containsString(def.SupportedModels, "FMC650") {
+		t.Errorf("family: expected FMC650 definition, got %+v", def)
+	}
+
+	// MyTracker is unknown, family members are tried in order
+	def, err = configured.GetElementInfo("MyTracker", AxisXID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Units != "G" {
+		t.Errorf("family: expected TMT250 definition of Axis X, got %+v", def)
+	}
+
+	if _, err = configured.GetElementInfo("XYZ", AxisXID); err == nil {
+		t.Error("unknown model accepted without wildcard fallback")
+	}
+
+	wildcard := decoder.WithModelConfig(&ModelConfig{Wildcard: true})
+	def, err = wildcard.GetElementInfo("XYZ", AxisXID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := decoder.GetElementInfo("*", AxisXID)
+	if def.Units != expected.Units || def.Multiplier != expected.Multiplier {
+		t.Errorf("wildcard: expected %+v, got %+v", expected, def)
+	}
+}
+
+func TestReadDefinitions(t *testing.T) {
+	compressed, err := ReadDefinitions(ioElementDefinitionsData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(compressed) == 0 || len(DefaultDecoder().ListModels()) == 0 {
+		t.Fatal("no embedded definitions")
+	}
+
+	plain, err := ReadDefinitions([]byte(`[{"id":239,"name":"Ignition","numBytes":1,"type":1,"max":1,"multiplier":1,` +
+		`"supportedModels":["FMB920"],"groups":["Permanent I/O elements"]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder := NewDecoder(plain)
+	it, err := decoder.Decode("FMB920", 239, []byte{1})
+	if err != nil || it.Value != true {
+		t.Errorf("unexpected decode result %v (%v)", it, err)
+	}
+
+	if _, err = ReadDefinitions([]byte{0x1f, 0x8b, 0}); err == nil {
+		t.Error("broken gzip data accepted")
+	}
+	if _, err = ReadDefinitions([]byte("{}")); err == nil {
+		t.Error("invalid json accepted")
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+//go:build ioelements_nodata
+
+package ioelements
+
+// built with the ioelements_nodata tag, definitions are not embedded, use NewDecoder with your own definitions
+func defaultDefinitions() []IOElementDefinition {
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+//go:build ioelements_nodata
+
+package ioelements
+
+import (
+	"testing"
+)
+
+func TestNoDataDecoder(t *testing.T) {
+	decoder := DefaultDecoder()
+	if models := decoder.ListModels(); len(models) != 0 {
+		t.Errorf("expected no models without embedded definitions, got %v", models)
+	}
+	if _, err := decoder.GetElementInfo("*", IgnitionID); err == nil {
+		t.Error("element info found without embedded definitions")
+	}
+	if _, err := decoder.Decode("FMB920", IgnitionID, []byte{1}); err == nil {
+		t.Error("element decoded without embedded definitions")
+	}
+
+	def := &IOElementDefinition{Id: 1, NumBytes: 1, Type: IOElementUnsigned, Max: 100, Multiplier: 1}
+	decoded, err := decoder.DecodeByDefinition(def, []byte{1})
+	if err != nil || decoded.Value != uint64(1) {
+		t.Errorf("unexpected decode result %v (%v)", decoded, err)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package ioelements
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"testing"
+)
+
+func TestEncodeMustFail(t *testing.T) {
+	cases := []struct {
+		def   IOElementDefinition
+		value interface{}
+	}{
+		{IOElementDefinition{Id: 1, NumBytes: 1, Type: IOElementUnsigned, Multiplier: 1}, 256},
+		{IOElementDefinition{Id: 1, NumBytes: 1, Type: IOElementUnsigned, Multiplier: 1}, -1},
+		{IOElementDefinition{Id: 1, NumBytes: 1, Type: IOElementSigned, Multiplier: 1}, 128},
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementUnsigned, Min: 0, Max: 100, Multiplier: 1}, 101},
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementUnsigned, Min: 0, Max: 100, Multiplier: 0.1}, 10.1},
+		{IOElementDefinition{Id: 1, NumBytes: 9, Type: IOElementUnsigned, Multiplier: 1}, 1},
+		{IOElementDefinition{Id: 1, NumBytes: 3, Type: IOElementUnsigned, Multiplier: 1}, 1 << 24},
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementUnsigned, Multiplier: 1}, "1"},
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementUnsigned, Multiplier: 1}, math.NaN()},
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementHEX, Multiplier: 1}, "0a"},
+		{IOElementDefinition{Id: 1, NumBytes: -1, Type: IOElementHEX, Multiplier: 1}, "zz"},
+		{IOElementDefinition{Id: 1, NumBytes: 4, Type: IOElementASCII, Multiplier: 1}, "hello"},
+	}
+
+	for i, c := range cases {
+		if _, err := Encode(&c.def, c.value); err == nil {
+			t.Errorf("case #%d: invalid value %v encoded successfully", i, c.value)
+		}
+	}
+}
+
+func TestDecodeOddWidths(t *testing.T) {
+	decoder := DefaultDecoder()
+	cases := []struct {
+		def      IOElementDefinition
+		buffer   string
+		expected interface{}
+	}{
+		{IOElementDefinition{Id: 1, NumBytes: 3, Type: IOElementUnsigned, Multiplier: 1}, "010203", uint64(0x010203)},
+		{IOElementDefinition{Id: 1, NumBytes: 3, Type: IOElementSigned, Multiplier: 1}, "fffffe", int64(-2)},
+		{IOElementDefinition{Id: 1, NumBytes: 6, Type: IOElementUnsigned, Multiplier: 1}, "0000000100ff", uint64(0x100ff)},
+		{IOElementDefinition{Id: 1, NumBytes: 6, Type: IOElementSigned, Multiplier: 0.5}, "ffffffffffff", -0.5},
+		// zero multiplier is treated as missing in both directions
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementUnsigned, Multiplier: 0}, "0102", uint64(0x0102)},
+		{IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementSigned, Multiplier: 0}, "fffe", int64(-2)},
+	}
+
+	for i, c := range cases {
+		buffer, _ := hex.DecodeString(c.buffer)
+		decoded, err := decoder.DecodeByDefinition(&c.def, buffer, &DecodeConfig{Mode: Strict})
+		if err != nil {
+			t.Fatalf("case #%d: %v", i, err)
+		}
+		if decoded.Value != c.expected {
+			t.Errorf("case #%d: decoded %v (%T), expected %v (%T)", i, decoded.Value, decoded.Value, c.expected, c.expected)
+		}
+		encoded, err := Encode(&c.def, decoded.Value)
+		if err != nil {
+			t.Fatalf("case #%d: %v", i, err)
+		}
+		if !bytes.Equal(encoded, buffer) {
+			t.Errorf("case #%d: encoded %s, expected %s", i, hex.EncodeToString(encoded), c.buffer)
+		}
+	}
+}
+
+func TestDecodeDiagnostics(t *testing.T) {
+	decoder := DefaultDecoder()
+	def := &IOElementDefinition{Id: 1, NumBytes: 2, Type: IOElementUnsigned, Min: 0, Max: 100, Multiplier: 1}
+
+	decoded, err := decoder.DecodeByDefinition(def, []byte{0, 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Diagnostics) != 0 {
+		t.Errorf("unexpected diagnostics %v", decoded.Diagnostics)
+	}
+
+	decoded, err = decoder.DecodeByDefinition(def, []byte{0, 0, 0, 101})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Diagnostics) != 2 || decoded.Diagnostics[0].Kind != OutOfRange || decoded.Diagnostics[1].Kind != WidthMismatch {
+		t.Errorf("expected out of range and width mismatch diagnostics, got %v", decoded.Diagnostics)
+	}
+	if decoded.Value != uint64(101) {
+		t.Errorf("lenient mode must keep the value, got %v", decoded.Value)
+	}
+
+	if _, err = decoder.DecodeByDefinition(def, []byte{0, 101}, &DecodeConfig{Mode: Strict}); err == nil {
+		t.Error("strict mode: out of range value decoded successfully")
+	}
+	if _, err = decoder.DecodeByDefinition(def, []byte{50}, &DecodeConfig{Mode: Strict}); err == nil {
+		t.Error("strict mode: width mismatch decoded successfully")
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package ioelements
+
+import (
+	"path"
+	"sort"
+)
+
+// ModelConfig configures how Decoder resolves model names that are not present in the definitions
+type ModelConfig struct {
+	// Aliases maps a model name or a pattern (path.Match syntax, e.g. "FMB920-*") to a model name.
+	// Exact aliases take precedence over patterns, patterns are tried in lexicographical order
+	Aliases map[string]string
+	// Families maps a family name to its members in order of precedence.
+	// If the model (or the family with the same name) has no definition for an element,
+	// the definition of the first family member that has it is used.
+	// If the model belongs to several families, families are tried in lexicographical order of their names
+	Families map[string][]string
+	// Wildcard enables fallback to the first definition with the requested id regardless of the model
+	// (same as passing '*' as the model name), including models unknown to the decoder
+	Wildcard bool
+}
+
+// WithModelConfig returns a copy of the decoder that resolves model names according to the config,
+// the original decoder is not modified, so it is safe to configure DefaultDecoder()
+func (r *Decoder) WithModelConfig(config *ModelConfig) *Decoder {
+	return &Decoder{definitions: r.definitions, supportedModels: r.supportedModels, modelConfig: config}
+}
+
+// ResolveModel returns the model name after alias resolution
+func (r *Decoder) ResolveModel(modelName string) string {
+	if r.modelConfig == nil || len(r.modelConfig.Aliases) == 0 {
+		return modelName
+	}
+	if model, ok := r.modelConfig.Aliases[modelName]; ok {
+		return model
+	}
+
+	patterns := make([]string, 0, len(r.modelConfig.Aliases))
+	for pattern := range r.modelConfig.Aliases {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, modelName); ok {
+			return r.modelConfig.Aliases[pattern]
+		}
+	}
+	return modelName
+}
+
+// resolveModels returns supported models in order of precedence: the model itself, then family members
+func (r *Decoder) resolveModels(modelName string) []string {
+	model := r.ResolveModel(modelName)
+	res := make([]string, 0, 1)
+	seen := map[string]bool{}
+	add := func(it string) {
+		if r.supportedModels[it] && !seen[it] {
+			seen[it] = true
+			res = append(res, it)
+		}
+	}
+	add(model)
+
+	if r.modelConfig == nil || len(r.modelConfig.Families) == 0 {
+		return res
+	}
+
+	families := make([]string, 0, len(r.modelConfig.Families))
+	for family := range r.modelConfig.Families {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	for _, family := range families {
+		members := r.modelConfig.Families[family]
+		if family != model && family != modelName && !containsString(members, model) && !containsString(members, modelName) {
+			continue
+		}
+		for _, member := range members {
+			add(r.ResolveModel(member))
+		}
+	}
+	return res
+}
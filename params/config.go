@@ -0,0 +1,135 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package params
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Values configuration parameter values by parameter id
+type Values map[uint32]string
+
+// ValueChange difference between the current and the desired parameter value
+type ValueChange struct {
+	Id      uint32 `json:"id"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+	Known   bool   `json:"known"` // false if the current value is unknown
+}
+
+// ReadConfigFile reads Teltonika Configurator .cfg file
+func ReadConfigFile(path string) (Values, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(data)
+}
+
+// ReadConfig reads Teltonika Configurator .cfg file content
+func ReadConfig(reader io.Reader) (Values, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses Teltonika Configurator .cfg file content: gzip compressed (or plain) text
+// with "id:value;" pairs, e.g. "2001:internet;2002:;2003:"
+func ParseConfig(data []byte) (Values, error) {
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("config gzip read error (%v)", err)
+		}
+		if data, err = io.ReadAll(reader); err != nil {
+			return nil, fmt.Errorf("config gzip read error (%v)", err)
+		}
+	}
+	text := strings.TrimPrefix(string(data), "\uFEFF")
+
+	res := Values{}
+	for i, pair := range strings.Split(text, ";") {
+		pair = strings.TrimLeft(pair, " \t\r\n")
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		idStr, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("config entry #%d '%s': expected id:value", i, pair)
+		}
+		id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("config entry #%d '%s': invalid parameter id", i, pair)
+		}
+		if _, ok = res[uint32(id)]; ok {
+			return nil, fmt.Errorf("config entry #%d: duplicate parameter %d", i, id)
+		}
+		res[uint32(id)] = strings.TrimRight(value, "\r\n")
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("config has no parameters")
+	}
+	return res, nil
+}
+
+// Ids returns sorted parameter ids
+func (r Values) Ids() []uint32 {
+	ids := make([]uint32, 0, len(r))
+	for id := range r {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Bytes returns .cfg text ("id:value;" pairs sorted by id), not compressed
+func (r Values) Bytes() []byte {
+	buf := &bytes.Buffer{}
+	for _, id := range r.Ids() {
+		_, _ = fmt.Fprintf(buf, "%d:%s;", id, r[id])
+	}
+	return buf.Bytes()
+}
+
+// Diff returns parameters whose desired value differs from the current one, sorted by id.
+// Only desired parameters are compared, a parameter missing in current is reported with Known = false
+func Diff(current, desired Values) []ValueChange {
+	var res []ValueChange
+	for _, id := range desired.Ids() {
+		value, ok := current[id]
+		if ok && value == desired[id] {
+			continue
+		}
+		res = append(res, ValueChange{Id: id, Current: value, Desired: desired[id], Known: ok})
+	}
+	return res
+}
+
+// Validate checks values against the catalogue definitions for the model, all errors are returned
+func (r *Catalogue) Validate(modelName string, values Values) []error {
+	var res []error
+	for _, id := range values.Ids() {
+		def, err := r.GetParam(modelName, id)
+		if err != nil {
+			res = append(res, err)
+			continue
+		}
+		if err = def.Validate(values[id]); err != nil {
+			res = append(res, fmt.Errorf("parameter %d (%s): %v", id, def.Name, err))
+		}
+	}
+	return res
+}
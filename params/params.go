@@ -0,0 +1,212 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+// Package params validates configuration parameters and parses .cfg files. The package ships no parameter
+// definitions: Catalogue is built from a list generated from the wiki with tools/io_elements_gen.go
+// (load-params-net), without one only the value syntax is checked
+package params
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type ValueType uint8
+
+const (
+	ParamUint8 ValueType = iota
+	ParamUint16
+	ParamUint32
+	ParamUint64
+	ParamInt8
+	ParamInt16
+	ParamInt32
+	ParamInt64
+	ParamDouble
+	ParamString
+)
+
+var valueTypeNames = []string{"Uint8", "Uint16", "Uint32", "Uint64", "Int8", "Int16", "Int32", "Int64", "Double", "String"}
+
+// ParamDefinition configuration parameter description.
+// For numeric parameters Min and Max limit the value, for strings - the value length.
+// Min and Max equal to 0 mean that the range is not documented
+type ParamDefinition struct {
+	Id              uint32    `json:"id"`
+	Name            string    `json:"name"`
+	Type            ValueType `json:"type"`
+	Min             float64   `json:"min"`
+	Max             float64   `json:"max"`
+	Default         string    `json:"default"`
+	Description     string    `json:"description"`
+	SupportedModels []string  `json:"supportedModels"`
+	Groups          []string  `json:"groups"`
+}
+
+// Catalogue provides lookup of configuration parameters by model
+type Catalogue struct {
+	definitions     []ParamDefinition
+	supportedModels map[string]bool
+}
+
+func (r ValueType) String() string {
+	if int(r) < len(valueTypeNames) {
+		return valueTypeNames[r]
+	}
+	return fmt.Sprintf("ValueType(%d)", r)
+}
+
+// NewCatalogue create new Catalogue
+func NewCatalogue(definitions []ParamDefinition) *Catalogue {
+	allSupportedModels := map[string]bool{}
+	for _, it := range definitions {
+		for _, model := range it.SupportedModels {
+			allSupportedModels[model] = true
+		}
+	}
+	return &Catalogue{definitions: definitions, supportedModels: allSupportedModels}
+}
+
+// GetParam returns parameter description by its id and model name
+// If you don't know the model name, you can skip the model name check by passing '*' as the model name
+func (r *Catalogue) GetParam(modelName string, id uint32) (*ParamDefinition, error) {
+	if modelName != "*" && !r.supportedModels[modelName] {
+		return nil, fmt.Errorf("model '%s' is not supported", modelName)
+	}
+	for _, it := range r.definitions {
+		if it.Id == id && (modelName == "*" || containsString(it.SupportedModels, modelName)) {
+			def := it
+			return &def, nil
+		}
+	}
+	return nil, fmt.Errorf("parameter with id %v not found", id)
+}
+
+// ListModels returns sorted names of all models known to the catalogue
+func (r *Catalogue) ListModels() []string {
+	res := make([]string, 0, len(r.supportedModels))
+	for model := range r.supportedModels {
+		res = append(res, model)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ParamsForModel returns all parameter definitions supported by the model
+// Passing '*' as the model name returns all definitions
+func (r *Catalogue) ParamsForModel(modelName string) ([]*ParamDefinition, error) {
+	return r.filter(modelName, func(*ParamDefinition) bool { return true })
+}
+
+// FindByName returns parameter definitions supported by the model which name contains all words of the query
+// (case-insensitive), names starting with the query come first
+// If you don't know the model name, you can skip the model name check by passing '*' as the model name
+func (r *Catalogue) FindByName(modelName string, query string) ([]*ParamDefinition, error) {
+	query = normalizeName(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty query")
+	}
+	res, err := r.filter(modelName, func(it *ParamDefinition) bool {
+		name := normalizeName(it.Name)
+		for _, word := range strings.Fields(query) {
+			if !strings.Contains(name, word) {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		a, b := strings.HasPrefix(normalizeName(res[i].Name), query), strings.HasPrefix(normalizeName(res[j].Name), query)
+		if a != b {
+			return a
+		}
+		if len(res[i].Name) != len(res[j].Name) {
+			return len(res[i].Name) < len(res[j].Name)
+		}
+		return res[i].Id < res[j].Id
+	})
+	return res, nil
+}
+
+// Validate checks that the value (as it is sent in setparam command) matches the parameter type and range
+func (r *ParamDefinition) Validate(value string) error {
+	hasRange := r.Min != 0 || r.Max != 0
+	var number float64
+	switch r.Type {
+	case ParamString:
+		length := float64(utf8.RuneCountInString(value))
+		if hasRange && (length < r.Min || length > r.Max) {
+			return fmt.Errorf("parameter %v: length of %q is out of range [%v, %v]", r.Id, value, r.Min, r.Max)
+		}
+		return nil
+	case ParamDouble:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("parameter %v: invalid number %q", r.Id, value)
+		}
+		number = v
+	case ParamInt8, ParamInt16, ParamInt32, ParamInt64:
+		v, err := strconv.ParseInt(value, 10, 8<<(r.Type-ParamInt8))
+		if err != nil {
+			return fmt.Errorf("parameter %v: invalid %s value %q", r.Id, r.Type, value)
+		}
+		number = float64(v)
+	case ParamUint8, ParamUint16, ParamUint32, ParamUint64:
+		v, err := strconv.ParseUint(value, 10, 8<<(r.Type-ParamUint8))
+		if err != nil {
+			return fmt.Errorf("parameter %v: invalid %s value %q", r.Id, r.Type, value)
+		}
+		number = float64(v)
+	default:
+		return fmt.Errorf("parameter %v: unknown value type %v", r.Id, r.Type)
+	}
+	if hasRange && (number < r.Min || number > r.Max) {
+		return fmt.Errorf("parameter %v: value %s is out of range [%v, %v]", r.Id, value, r.Min, r.Max)
+	}
+	return nil
+}
+
+func (r *Catalogue) filter(modelName string, check func(it *ParamDefinition) bool) ([]*ParamDefinition, error) {
+	if modelName != "*" && !r.supportedModels[modelName] {
+		return nil, fmt.Errorf("model '%s' is not supported", modelName)
+	}
+	res := make([]*ParamDefinition, 0)
+	for i := range r.definitions {
+		it := r.definitions[i]
+		if modelName != "*" && !containsString(it.SupportedModels, modelName) {
+			continue
+		}
+		if check(&it) {
+			res = append(res, &it)
+		}
+	}
+	return res, nil
+}
+
+// normalizeName converts name to lower case and replaces punctuation with single spaces
+func normalizeName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}), " ")
+}
+
+func containsString(list []string, value string) bool {
+	for _, it := range list {
+		if it == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+// Copyright 2022-2024 Alim Zanibekov
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file or at
+// https://opensource.org/licenses/MIT.
+
+package params
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testCatalogue parameters of the sample pages in tools/testdata/params
+func testCatalogue() *Catalogue {
+	models, groups := []string{"FMB920", "FMC650"}, []string{"GPRS parameters"}
+	return NewCatalogue([]ParamDefinition{
+		{102, "Sleep mode", ParamUint8, 0, 4, "0", "", models, []string{"System parameters"}},
+		{2001, "APN Name", ParamString, 0, 32, "", "Access Point Name", models, groups},
+		{2002, "APN username", ParamString, 0, 30, "", "APN username", models, groups},
+		{2003, "APN Password", ParamString, 0, 30, "", "APN password", models, groups},
+		{2004, "Domain", ParamString, 0, 55, "", "Server domain or IP address", models, groups},
+		{2005, "Target Server Port", ParamUint16, 0, 65535, "0", "Server port", models, groups},
+		{2006, "Protocol", ParamUint8, 0, 1, "0", "0 - TCP\n1 - UDP", models, groups},
+	})
+}
+
+func TestCatalogue(t *testing.T) {
+	catalogue := testCatalogue()
+	if len(catalogue.ListModels()) != 2 {
+		t.Fatalf("unexpected models %v", catalogue.ListModels())
+	}
+
+	def, err := catalogue.GetParam("FMB920", 2004)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Type != ParamString || def.Name != "Domain" {
+		t.Errorf("unexpected definition %+v", def)
+	}
+	if _, err = catalogue.GetParam("XYZ", 2004); err == nil {
+		t.Error("unknown model accepted")
+	}
+	if _, err = catalogue.GetParam("*", 65000); err == nil {
+		t.Error("unknown parameter found")
+	}
+
+	found, err := catalogue.FindByName("*", "apn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 3 || found[0].Id != 2001 {
+		t.Errorf("unexpected search result %v", found)
+	}
+	all, _ := catalogue.ParamsForModel("FMB920")
+	if len(all) < len(found) {
+		t.Errorf("unexpected number of FMB920 parameters %d", len(all))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	catalogue := NewCatalogue([]ParamDefinition{
+		{Id: 1, Type: ParamUint8, Min: 0, Max: 4, SupportedModels: []string{"FMB920"}},
+		{Id: 2, Type: ParamInt16, Min: -100, Max: 100, SupportedModels: []string{"FMB920"}},
+		{Id: 3, Type: ParamString, Min: 0, Max: 5, SupportedModels: []string{"FMB920"}},
+		{Id: 4, Type: ParamDouble, Min: -90, Max: 90, SupportedModels: []string{"FMB920"}},
+		{Id: 5, Type: ParamUint32, SupportedModels: []string{"FMB920"}},
+	})
+	cases := []struct {
+		id    uint32
+		value string
+		valid bool
+	}{
+		{1, "4", true}, {1, "5", false}, {1, "-1", false}, {1, "abc", false}, {1, "256", false},
+		{2, "-100", true}, {2, "101", false},
+		{3, "", true}, {3, "hello", true}, {3, "hello!", false},
+		{4, "54.6872", true}, {4, "91", false}, {4, "NaN", false},
+		{5, "4294967295", true}, {5, "4294967296", false},
+	}
+	for _, it := range cases {
+		def, err := catalogue.GetParam("FMB920", it.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = def.Validate(it.value); (err == nil) != it.valid {
+			t.Errorf("parameter %d value %q: expected valid=%v, got %v", it.id, it.value, it.valid, err)
+		}
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	values, err := ReadConfigFile("testdata/FMB920.cfg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Values{102: "2", 2001: "internet", 2002: "", 2003: "", 2004: "my.server.com", 2005: "5027", 2006: "0"}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("unexpected values %v", values)
+	}
+	if errs := testCatalogue().Validate("FMB920", values); len(errs) != 0 {
+		t.Errorf("unexpected validation errors %v", errs)
+	}
+
+	plain, err := ParseConfig([]byte("\uFEFF2001:a b;\r\n2002:x:y;"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(plain, Values{2001: "a b", 2002: "x:y"}) {
+		t.Errorf("unexpected values %v", plain)
+	}
+	if string(plain.Bytes()) != "2001:a b;2002:x:y;" {
+		t.Errorf("unexpected bytes '%s'", plain.Bytes())
+	}
+
+	for _, it := range []string{"", "2001", "x:1;", "2001:a;2001:b;"} {
+		if _, err = ParseConfig([]byte(it)); err == nil {
+			t.Errorf("invalid config '%s' parsed", it)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	current := Values{2001: "internet", 2002: "user", 9999: "x"}
+	desired := Values{2001: "internet", 2002: "admin", 2003: "secret"}
+	changes := Diff(current, desired)
+	expected := []ValueChange{
+		{Id: 2002, Current: "user", Desired: "admin", Known: true},
+		{Id: 2003, Desired: "secret"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected diff %+v", changes)
+	}
+}
@@ -7,12 +7,15 @@
 package teltonika
 
 import (
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"strings"
+	"unicode/utf8"
 )
 
 type GenerationType uint8
@@ -100,11 +103,16 @@ type IOElement struct {
 	Value IOElementValue `json:"value"`
 }
 
+// MessagePayload raw message bytes, encoded to JSON as a hex string,
+// a string with "base64:" prefix is decoded as base64 (standard encoding)
+type MessagePayload []byte
+
 type Message struct {
-	Timestamp uint32      `json:"timestamp,omitempty"` // if codec is 13 or 15 else 0
-	Type      MessageType `json:"type"`                // may contain an arbitrary value if codec is 15
-	Imei      string      `json:"imei,omitempty"`      // if codec is 14 or 15 else ""
-	Text      string      `json:"text"`
+	Timestamp uint32         `json:"timestamp,omitempty"` // if codec is 13 or 15 else 0
+	Type      MessageType    `json:"type"`                // may contain an arbitrary value if codec is 15
+	Imei      string         `json:"imei,omitempty"`      // if codec is 14 or 15 else ""
+	Text      string         `json:"text"`
+	Payload   MessagePayload `json:"payload,omitempty"` // raw bytes, set on decode if Text is not valid UTF-8, if not nil encoded instead of Text
 }
 
 // DecodeConfig optional configuration that can be passed in all Decode* functions (last param).
@@ -126,6 +134,40 @@ func (r IOElementValue) UnmarshalJSON(data []byte) error {
 	return err
 }
 
+func (r MessagePayload) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + hex.EncodeToString(r) + `"`), nil
+}
+
+func (r *MessagePayload) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	var err error
+	if encoded := strings.TrimPrefix(str, "base64:"); encoded != str {
+		*r, err = base64.StdEncoding.DecodeString(encoded)
+	} else {
+		*r, err = hex.DecodeString(str)
+	}
+	return err
+}
+
+// Bytes returns Payload, or Text bytes if Payload is nil
+func (r *Message) Bytes() []byte {
+	if r.Payload != nil {
+		return r.Payload
+	}
+	return []byte(r.Text)
+}
+
+// size returns the length of the message content
+func (r *Message) size() int {
+	if r.Payload != nil {
+		return len(r.Payload)
+	}
+	return len(r.Text)
+}
+
 func (r PacketResponse) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + hex.EncodeToString(r) + `"`), nil
 }
@@ -638,6 +680,10 @@ func decodeCommand(codecId CodecId, reader *byteReader, data *Message) error {
 		return err
 	}
 	data.Text = string(command)
+	if !utf8.Valid(command) {
+		// JSON replaces invalid UTF-8 in Text, the exact bytes are kept in Payload
+		data.Payload = MessagePayload(data.Text)
+	}
 	return nil
 }
 
@@ -842,7 +888,7 @@ func encodeTCPInternal(packet *Packet) ([]byte, error) {
 	dataSize := 3 // packet fields size
 	if isCMDCodecId(uint8(packet.CodecID)) {
 		for _, command := range packet.Messages {
-			dataSize += len(command.Text) + 5
+			dataSize += command.size() + 5
 			if packet.CodecID == Codec14 {
 				dataSize += 8
 			} else if packet.CodecID == Codec13 {
@@ -907,7 +953,7 @@ func encodeUDPInternal(imei string, packetId uint16, avlPacketId uint8, packet *
 
 	if isCMDCodecId(uint8(packet.CodecID)) {
 		for _, command := range packet.Messages {
-			dataSize += len(command.Text) + 5
+			dataSize += command.size() + 5
 			if packet.CodecID == Codec14 {
 				dataSize += 8
 			} else if packet.CodecID == Codec13 {
@@ -1012,7 +1058,7 @@ func encodeMessage(codecId CodecId, message *Message, buf []byte) (int, error) {
 		return 0, fmt.Errorf("message type 0x%X is not supported with codec %d", message.Type, codecId)
 	}
 
-	size := len(message.Text)
+	size := message.size()
 	if codecId == Codec14 {
 		size += 8
 	} else if codecId == Codec13 {
@@ -1050,8 +1096,12 @@ func encodeMessage(codecId CodecId, message *Message, buf []byte) (int, error) {
 		pos += 8
 	}
 
-	copy(buf[pos:], message.Text)
-	pos += len(message.Text)
+	if message.Payload != nil {
+		copy(buf[pos:], message.Payload)
+	} else {
+		copy(buf[pos:], message.Text)
+	}
+	pos += message.size()
 
 	return pos, nil
 }
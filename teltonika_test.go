@@ -810,3 +810,70 @@ func crc16IBMNoTable(data []byte) uint16 {
 	}
 	return crc
 }
+
+func TestMessagePayload(t *testing.T) {
+	payload := []byte{0x00, 0xff, 0x7e, 0x0a, 0x80}
+	buffer, err := EncodePacketTCP(&Packet{
+		CodecID:  Codec12,
+		Messages: []Message{{Type: TypeCommand, Text: "ignored", Payload: payload}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, decoded, err := DecodeTCPFromSlice(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := decoded.Packet.Messages[0]
+	if !bytes.Equal(message.Payload, payload) || !bytes.Equal(message.Bytes(), payload) || message.Text != string(payload) {
+		t.Errorf("unexpected message %+v", message)
+	}
+
+	res, err := json.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(res), `"payload":"00ff7e0a80"`) {
+		t.Errorf("unexpected json %s", res)
+	}
+	var unmarshaled Message
+	if err = json.Unmarshal(res, &unmarshaled); err != nil || !bytes.Equal(unmarshaled.Payload, payload) {
+		t.Errorf("unexpected payload %v (%v)", unmarshaled.Payload, err)
+	}
+	if err = json.Unmarshal([]byte(`{"type":5,"text":"","payload":"base64:AP9+CoA="}`), &unmarshaled); err != nil ||
+		!bytes.Equal(unmarshaled.Payload, payload) {
+		t.Errorf("unexpected payload %v (%v)", unmarshaled.Payload, err)
+	}
+	if err = json.Unmarshal([]byte(`{"payload":"xyz"}`), &unmarshaled); err == nil {
+		t.Error("invalid payload accepted")
+	}
+}
+
+func TestMessageEditText(t *testing.T) {
+	buffer, err := EncodePacketTCP(&Packet{CodecID: Codec12, Messages: []Message{{Type: TypeCommand, Text: "getinfo"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, decoded, err := DecodeTCPFromSlice(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := &decoded.Packet.Messages[0]
+	if message.Payload != nil {
+		t.Errorf("payload is set for the text message %+v", message)
+	}
+	if res, _ := json.Marshal(message); strings.Contains(string(res), "payload") {
+		t.Errorf("unexpected json %s", res)
+	}
+
+	message.Text = "getver"
+	if buffer, err = EncodePacketTCP(decoded.Packet); err != nil {
+		t.Fatal(err)
+	}
+	if _, decoded, err = DecodeTCPFromSlice(buffer); err != nil {
+		t.Fatal(err)
+	}
+	if text := decoded.Packet.Messages[0].Text; text != "getver" {
+		t.Errorf("edited text is not encoded, got '%s'", text)
+	}
+}
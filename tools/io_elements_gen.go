@@ -1,16 +1,24 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	"go/token"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
@@ -54,28 +62,122 @@ type IOElementDefinition struct {
 	Groups          StringSlice `json:"groups" csv:"Groups"`
 }
 
+type ParamType uint8
+
+// ParamDefinition configuration parameter, the same as params.ParamDefinition
+type ParamDefinition struct {
+	Id              uint32      `json:"id" csv:"Id"`
+	Name            string      `json:"name" csv:"Name"`
+	Type            ParamType   `json:"type" csv:"Type"`
+	Min             float64     `json:"min" csv:"Min"`
+	Max             float64     `json:"max" csv:"Max"`
+	Default         string      `json:"default" csv:"Default"`
+	Description     string      `json:"description" csv:"Description"`
+	SupportedModels StringSlice `json:"supportedModels" csv:"SupportedModels"`
+	Groups          StringSlice `json:"groups" csv:"Groups"`
+}
+
 type NetworkParserOptions struct {
-	Models     []string       `short:"m" long:"model" description:"models to parse, can be specified multiple times" default:"FMB920" default:"FMC650" required:"yes"`
+	Models      []string       `short:"m" long:"model" description:"models to parse, can be specified multiple times" default:"FMB920" default:"FMC650" required:"yes"`
+	CsvOutput   flags.Filename `long:"csv-out" description:"csv output file path"`
+	JsonOutput  flags.Filename `long:"json-out" description:"json output file path"`
+	JsonlOutput flags.Filename `long:"jsonl-out" description:"json lines output file path"`
+	Cache       flags.Filename `long:"cache" description:"path to http cache file" default:"./cache.bin"`
+	NoCache     bool           `long:"no-cache" description:"disable http cache"`
+	NoFollow    bool           `long:"no-follow" description:"disable recursive links following"`
+	UrlPattern  string         `long:"url-pattern" description:"url generation pattern from specified model names\n substring '{model}' will be substituted\n" default:"https://wiki.teltonika-gps.com/view/{model}_Teltonika_Data_Sending_Parameters_ID"`
+}
+
+type HtmlParserOptions struct {
+	Models      []string       `short:"m" long:"model" description:"models to start from, can be specified multiple times (default: all pages in the directory)"`
+	CsvOutput   flags.Filename `long:"csv-out" description:"csv output file path"`
+	JsonOutput  flags.Filename `long:"json-out" description:"json output file path"`
+	JsonlOutput flags.Filename `long:"jsonl-out" description:"json lines output file path"`
+	NoFollow    bool           `long:"no-follow" description:"disable recursive links following"`
+	Arg         struct {
+		InputDir flags.Filename `positional-arg-name:"InputHtmlDir"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+type CsvParserOptions struct {
+	JsonOutput  flags.Filename `long:"json-out" description:"json output file path"`
+	JsonlOutput flags.Filename `long:"jsonl-out" description:"json lines output file path"`
+	Arg         struct {
+		InputFile flags.Filename `positional-arg-name:"InputCsvFile"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+type JsonParserOptions struct {
+	CsvOutput   flags.Filename `long:"csv-out" description:"csv output file path"`
+	JsonOutput  flags.Filename `long:"json-out" description:"json output file path"`
+	JsonlOutput flags.Filename `long:"jsonl-out" description:"json lines output file path"`
+	Arg         struct {
+		InputFile flags.Filename `positional-arg-name:"InputJsonFile"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+type ParamsNetworkParserOptions struct {
+	Models     []string       `short:"m" long:"model" description:"models to parse, can be specified multiple times" default:"FMB920" required:"yes"`
 	CsvOutput  flags.Filename `long:"csv-out" description:"csv output file path"`
+	JsonOutput flags.Filename `long:"json-out" description:"json output file path"`
 	Cache      flags.Filename `long:"cache" description:"path to http cache file" default:"./cache.bin"`
 	NoCache    bool           `long:"no-cache" description:"disable http cache"`
-	NoFollow   bool           `long:"no-follow" description:"disable recursive links following"`
-	UrlPattern string         `long:"url-pattern" description:"url generation pattern from specified model names\n substring '{model}' will be substituted\n" default:"https://wiki.teltonika-gps.com/view/{model}_Teltonika_Data_Sending_Parameters_ID"`
+	UrlPattern string         `long:"url-pattern" description:"url generation pattern from specified model names\n substring '{model}' will be substituted\n" default:"https://wiki.teltonika-gps.com/view/{model}_Parameter_list"`
 }
 
-type CsvParserOptions struct {
-	Arg struct {
+type ParamsHtmlParserOptions struct {
+	Models     []string       `short:"m" long:"model" description:"models to parse, can be specified multiple times (default: all pages in the directory)"`
+	CsvOutput  flags.Filename `long:"csv-out" description:"csv output file path"`
+	JsonOutput flags.Filename `long:"json-out" description:"json output file path"`
+	Arg        struct {
+		InputDir flags.Filename `positional-arg-name:"InputHtmlDir"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+type ParamsCsvParserOptions struct {
+	JsonOutput flags.Filename `long:"json-out" description:"json output file path"`
+	Arg        struct {
 		InputFile flags.Filename `positional-arg-name:"InputCsvFile"`
 	} `positional-args:"yes" required:"yes"`
 }
 
+type DiffOptions struct {
+	JsonOutput     flags.Filename `long:"json-out" description:"write machine-readable changelog to the json file"`
+	FailOnBreaking bool           `long:"fail-on-breaking" description:"exit with code 2 if changes affect decoding"`
+	Arg            struct {
+		Old flags.Filename `positional-arg-name:"OLD"`
+		New flags.Filename `positional-arg-name:"NEW"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+type LintOptions struct {
+	JsonOutput     flags.Filename `long:"json-out" description:"write issues to the json file"`
+	Disable        []string       `long:"disable" description:"disable the rule, can be specified multiple times"`
+	FailOnWarnings bool           `long:"fail-on-warnings" description:"exit with non-zero code on warnings too"`
+	Arg            struct {
+		InputFile flags.Filename `positional-arg-name:"FILE"`
+	} `positional-args:"yes" required:"yes"`
+}
+
 type Options struct {
-	ParseNetwork NetworkParserOptions `command:"load-net" optional:"true"`
-	ParseCsv     CsvParserOptions     `command:"load-csv" optional:"true"`
-	GenOutput    flags.Filename       `short:"o" long:"gen-out" default:"./ioelements_dump.go" description:"output file path for I/O elements definitions list"`
-	NoGen        bool                 `long:"no-gen" description:"disable go file generation"`
-	GenPkgName   string               `long:"gen-pkg-name" default:"main" description:"package name for generated file"`
-	GenInternal  bool                 `long:"gen-internal" description:"generate file for internal usage in ioelements package"`
+	ParseNetwork NetworkParserOptions       `command:"load-net" optional:"true"`
+	ParseCsv     CsvParserOptions           `command:"load-csv" optional:"true"`
+	ParseJson    JsonParserOptions          `command:"load-json" optional:"true"`
+	ParseHtml    HtmlParserOptions          `command:"parse-html" optional:"true"`
+	ParamsNet    ParamsNetworkParserOptions `command:"load-params-net" optional:"true" description:"load configuration parameters from wiki pages"`
+	ParamsHtml   ParamsHtmlParserOptions    `command:"parse-params-html" optional:"true" description:"load configuration parameters from saved wiki pages"`
+	ParamsCsv    ParamsCsvParserOptions     `command:"load-params-csv" optional:"true" description:"load configuration parameters from a csv file"`
+	Diff         DiffOptions                `command:"diff" optional:"true" description:"compare two definition sets (go dump, csv or json)"`
+	Lint         LintOptions                `command:"lint" optional:"true" description:"check a definition set (go dump, csv or json) for inconsistencies"`
+	GenOutput    flags.Filename             `short:"o" long:"gen-out" default:"./ioelements_dump.go" description:"output file path for I/O elements definitions list"`
+	NoGen        bool                       `long:"no-gen" description:"disable go file generation"`
+	GenPkgName   string                     `long:"gen-pkg-name" default:"main" description:"package name for generated file"`
+	GenInternal  bool                       `long:"gen-internal" description:"generate file for internal usage in ioelements (params) package"`
+	GenFormat    string                     `long:"gen-format" choice:"go" choice:"embed" default:"go" description:"go - definitions as a go slice literal, embed - gzip compressed json file embedded with go:embed and parsed on first use"`
+	GenModels    []string                   `long:"gen-model" description:"write only definitions of these models to the go output, can be specified multiple times"`
+	GenIdsOutput flags.Filename             `long:"gen-ids-out" description:"output file path for I/O element id constants and typed accessors"`
+	Patches      []flags.Filename           `long:"patch" description:"json patch file applied to the loaded definitions, can be specified multiple times"`
+	ParamsOutput flags.Filename             `long:"params-out" default:"./params_dump.go" description:"output file path for configuration parameters list"`
 }
 
 var options Options
@@ -91,19 +193,57 @@ func main() {
 		os.Exit(1)
 	}
 	switch parser.Command.Active.Name {
+	case "diff":
+		runDiff()
+		return
+	case "lint":
+		runLint()
+		return
+	case "load-params-net", "parse-params-html", "load-params-csv":
+		runParams()
+		return
+	}
+
+	var res []*IOElementDefinition
+	var csvOutput, jsonOutput, jsonlOutput flags.Filename
+	switch parser.Command.Active.Name {
 	case "load-net":
-		res := collectDefinitionsNetwork(options.ParseNetwork.Models)
-		if options.ParseNetwork.CsvOutput != "" {
-			dumpCsv(res, string(options.ParseNetwork.CsvOutput))
-		}
-		if !options.NoGen {
-			generate(res, string(options.GenOutput))
-		}
+		res = collectDefinitionsNetwork(options.ParseNetwork.Models)
+		csvOutput = options.ParseNetwork.CsvOutput
+		jsonOutput, jsonlOutput = options.ParseNetwork.JsonOutput, options.ParseNetwork.JsonlOutput
+	case "parse-html":
+		res = collectDefinitionsHtml(string(options.ParseHtml.Arg.InputDir), options.ParseHtml.Models)
+		csvOutput = options.ParseHtml.CsvOutput
+		jsonOutput, jsonlOutput = options.ParseHtml.JsonOutput, options.ParseHtml.JsonlOutput
 	case "load-csv":
-		res := readCsv(string(options.ParseCsv.Arg.InputFile))
-		if !options.NoGen {
-			generate(res, string(options.GenOutput))
-		}
+		res = readCsv(string(options.ParseCsv.Arg.InputFile))
+		jsonOutput, jsonlOutput = options.ParseCsv.JsonOutput, options.ParseCsv.JsonlOutput
+	case "load-json":
+		res = readJson(string(options.ParseJson.Arg.InputFile))
+		csvOutput = options.ParseJson.CsvOutput
+		jsonOutput, jsonlOutput = options.ParseJson.JsonOutput, options.ParseJson.JsonlOutput
+	}
+
+	for _, patchFile := range options.Patches {
+		var report []PatchResult
+		res, report = applyPatchFile(res, string(patchFile))
+		printPatchReport(string(patchFile), report)
+	}
+
+	if csvOutput != "" {
+		dumpCsv(res, string(csvOutput))
+	}
+	if jsonOutput != "" {
+		dumpJson(res, string(jsonOutput), false)
+	}
+	if jsonlOutput != "" {
+		dumpJson(res, string(jsonlOutput), true)
+	}
+	if !options.NoGen {
+		generate(res, string(options.GenOutput))
+	}
+	if options.GenIdsOutput != "" {
+		generateIds(res, string(options.GenIdsOutput))
 	}
 }
 
@@ -112,6 +252,10 @@ func (r *StringSlice) MarshalCSV() (string, error) {
 }
 
 func (r *StringSlice) UnmarshalCSV(csv string) (err error) {
+	if strings.TrimSpace(csv) == "" {
+		*r = StringSlice{}
+		return nil
+	}
 	res := strings.Split(csv, ",")
 	for i := range res {
 		res[i] = strings.TrimSpace(res[i])
@@ -150,7 +294,105 @@ func (r *ElementType) UnmarshalCSV(csv string) (err error) {
 	return nil
 }
 
+// UnmarshalJSON accepts both the numeric type and the type name used in csv ("Unsigned", "Hex", ...)
+func (r *ElementType) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return err
+		}
+		return r.UnmarshalCSV(name)
+	}
+	var value uint8
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value > uint8(IOElementASCII) {
+		return fmt.Errorf("unknown element type: %v", value)
+	}
+	*r = ElementType(value)
+	return nil
+}
+
 func generate(data []*IOElementDefinition, output string) {
+	if len(options.GenModels) > 0 {
+		data = subsetModels(data, options.GenModels)
+	}
+	if options.GenFormat == "embed" {
+		generateEmbedded(data, output)
+	} else {
+		generateLiteral(data, output)
+	}
+}
+
+// subsetModels returns definitions supported by any of the models, other models are removed from SupportedModels
+func subsetModels(data []*IOElementDefinition, models []string) []*IOElementDefinition {
+	res := make([]*IOElementDefinition, 0)
+	for _, it := range data {
+		supportedModels := StringSlice{}
+		for _, model := range it.SupportedModels {
+			if slices.Contains(models, model) {
+				supportedModels = append(supportedModels, model)
+			}
+		}
+		if len(supportedModels) > 0 {
+			def := *it
+			def.SupportedModels = supportedModels
+			res = append(res, &def)
+		}
+	}
+	return res
+}
+
+// generateEmbedded writes definitions to a gzip compressed json file (output with .json.gz extension)
+// and a go file which embeds it
+func generateEmbedded(data []*IOElementDefinition, output string) {
+	raw, err := json.Marshal(cleanDefinitions(data))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	gz, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if _, err = gz.Write(raw); err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	dataOutput := strings.TrimSuffix(output, ".go") + ".json.gz"
+	if err = os.WriteFile(dataOutput, buf.Bytes(), 0644); err != nil {
+		log.Fatalf("error writing to %s: %v", dataOutput, err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Code generated by io_elements_gen.go; DO NOT EDIT.\n\n")
+	if options.GenInternal {
+		sb.WriteString("//go:build !ioelements_nodata\n\n")
+		sb.WriteString("package " + options.GenPkgName + "\n\n")
+		sb.WriteString("import _ \"embed\"\n\n")
+	} else {
+		sb.WriteString("package " + options.GenPkgName + "\n\n")
+		sb.WriteString("import (\n\t_ \"embed\"\n\n\t\"github.com/alim-zanibekov/teltonika/ioelements\"\n)\n\n")
+	}
+	sb.WriteString("//go:embed " + filepath.Base(dataOutput) + "\n")
+	sb.WriteString("var ioElementDefinitionsData []byte\n\n")
+	if options.GenInternal {
+		sb.WriteString("func defaultDefinitions() []IOElementDefinition {\n")
+		sb.WriteString("\treturn mustReadDefinitions(ioElementDefinitionsData)\n}\n")
+	} else {
+		sb.WriteString("// loadIOElementDefinitions parses the embedded definitions, pass the result to ioelements.NewDecoder\n")
+		sb.WriteString("func loadIOElementDefinitions() ([]ioelements.IOElementDefinition, error) {\n")
+		sb.WriteString("\treturn ioelements.ReadDefinitions(ioElementDefinitionsData)\n}\n")
+	}
+
+	if err = os.WriteFile(output, []byte(sb.String()), 0644); err != nil {
+		log.Fatalf("error writing to %s: %v", output, err)
+	}
+}
+
+// generateLiteral writes definitions as a go slice literal
+func generateLiteral(data []*IOElementDefinition, output string) {
 	allSupportedModelsMap := map[string]bool{}
 	allSupportedModels := make([]string, 0)
 	for _, it := range data {
@@ -169,6 +411,9 @@ func generate(data []*IOElementDefinition, output string) {
 	}
 
 	var sb strings.Builder
+	if options.GenInternal {
+		sb.WriteString("//go:build !ioelements_nodata\n\n")
+	}
 	sb.WriteString("package " + options.GenPkgName + "\n\n")
 	prefix := ""
 	if !options.GenInternal {
@@ -221,6 +466,9 @@ func generate(data []*IOElementDefinition, output string) {
 		sb.WriteString(line)
 	}
 	sb.WriteString("}\n")
+	if options.GenInternal {
+		sb.WriteString("\nfunc defaultDefinitions() []IOElementDefinition {\n\treturn ioElementDefinitions\n}\n")
+	}
 
 	f, err := os.Create(output)
 	if err != nil {
@@ -234,6 +482,257 @@ func generate(data []*IOElementDefinition, output string) {
 	}
 }
 
+// packageIdentifiers returns top-level names declared in the Go files of the directory (the package
+// the generated file is written to), generated identifiers must not clash with them.
+// Test files and the file with the given path are skipped
+func packageIdentifiers(dir string, skip string) (map[string]bool, error) {
+	res := map[string]bool{}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	skipAbs, _ := filepath.Abs(skip)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if abs, _ := filepath.Abs(path); abs == skipAbs {
+			continue
+		}
+		file, err := goparser.ParseFile(token.NewFileSet(), path, nil, goparser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch it := decl.(type) {
+			case *ast.FuncDecl:
+				if it.Recv == nil {
+					res[it.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range it.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						res[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, ident := range spec.Names {
+							res[ident.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// generatedIds returns the id constants of the previously generated ids file, empty if the file does not exist
+func generatedIds(path string) (map[string]uint16, error) {
+	res := map[string]uint16{}
+	file, err := goparser.ParseFile(token.NewFileSet(), path, nil, goparser.SkipObjectResolution)
+	if errors.Is(err, os.ErrNotExist) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value, ok := spec.(*ast.ValueSpec)
+			if !ok || len(value.Names) != 1 || len(value.Values) != 1 {
+				continue
+			}
+			lit, ok := value.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				continue
+			}
+			if id, err := strconv.ParseUint(lit.Value, 0, 16); err == nil {
+				res[value.Names[0].Name] = uint16(id)
+			}
+		}
+	}
+	return res, nil
+}
+
+type idEntry struct {
+	ident     string
+	id        uint16
+	name      string
+	models    map[string]bool
+	accessor  string
+	constName string
+	varName   string
+}
+
+// generateIds writes exported id constants and typed accessors for every (name, id) pair.
+// If the same name is used by several ids, the others are suffixed with their id (e.g. "ModemUptime_25015ID").
+// The plain name keeps the id it has in the previous output file, so the committed file pins the names,
+// a new name goes to the id supported by most models (the lowest id if equal)
+func generateIds(data []*IOElementDefinition, output string) {
+	entries := make([]*idEntry, 0)
+	entryByKey := map[string]*idEntry{}
+	entriesByIdent := map[string][]*idEntry{}
+
+	for _, m := range data {
+		ident := toGoIdentifier(m.Name)
+		key := fmt.Sprintf("%s/%d", ident, m.Id)
+		entry, ok := entryByKey[key]
+		if !ok {
+			entry = &idEntry{ident: ident, id: m.Id, name: m.Name, models: map[string]bool{}}
+			entryByKey[key] = entry
+			entries = append(entries, entry)
+			entriesByIdent[ident] = append(entriesByIdent[ident], entry)
+		}
+		for _, model := range m.SupportedModels {
+			entry.models[model] = true
+		}
+		accessor := accessorType(m)
+		if entry.accessor == "" {
+			entry.accessor = accessor
+		} else if entry.accessor != accessor {
+			entry.accessor = "Element"
+		}
+	}
+
+	used, err := packageIdentifiers(filepath.Dir(output), output)
+	if err != nil {
+		log.Fatalf("error reading identifiers of the output package: %v", err)
+	}
+	previous, err := generatedIds(output)
+	if err != nil {
+		log.Fatalf("error reading previously generated ids: %v", err)
+	}
+	for _, e := range entries {
+		e.varName = fmt.Sprintf("%s_%d", e.ident, e.id)
+		e.constName = e.varName + "ID"
+		used[e.varName] = true
+		used[e.constName] = true
+	}
+
+	idents := make([]string, 0, len(entriesByIdent))
+	for ident := range entriesByIdent {
+		idents = append(idents, ident)
+	}
+	slices.Sort(idents)
+	for _, ident := range idents {
+		if used[ident] || used[ident+"ID"] {
+			continue
+		}
+		var main *idEntry
+		if id, ok := previous[ident+"ID"]; ok {
+			for _, e := range entriesByIdent[ident] {
+				if e.id == id {
+					main = e
+				}
+			}
+			if main == nil {
+				// the id is gone, the name is not moved to another id
+				continue
+			}
+		} else {
+			main = entriesByIdent[ident][0]
+			for _, e := range entriesByIdent[ident][1:] {
+				if len(e.models) > len(main.models) || len(e.models) == len(main.models) && e.id < main.id {
+					main = e
+				}
+			}
+		}
+		main.varName = ident
+		main.constName = ident + "ID"
+		used[main.varName] = true
+		used[main.constName] = true
+	}
+
+	slices.SortStableFunc(entries, func(a, b *idEntry) int {
+		if a.id != b.id {
+			return int(a.id) - int(b.id)
+		}
+		return strings.Compare(a.constName, b.constName)
+	})
+
+	var sb strings.Builder
+	sb.WriteString("// Code generated by io_elements_gen.go; DO NOT EDIT.\n\n")
+	sb.WriteString("package " + options.GenPkgName + "\n\n")
+	prefix := ""
+	if !options.GenInternal {
+		sb.WriteString(`import "github.com/alim-zanibekov/teltonika/ioelements"` + "\n\n")
+		prefix = "ioelements."
+	}
+
+	sb.WriteString("// I/O element ids\n")
+	sb.WriteString("const (\n")
+	for _, e := range entries {
+		sb.WriteString(fmt.Sprintf("\t%s uint16 = %d // %s\n", e.constName, e.id, strings.Join(strings.Fields(e.name), " ")))
+	}
+	sb.WriteString(")\n\n")
+
+	sb.WriteString("// Typed I/O element accessors\n")
+	sb.WriteString("var (\n")
+	for _, e := range entries {
+		sb.WriteString(fmt.Sprintf("\t%s = %s%s{%s}\n", e.varName, prefix, e.accessor, e.constName))
+	}
+	sb.WriteString(")\n")
+
+	source, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		log.Fatalf("error formatting generated ids file: %v", err)
+	}
+
+	if err = os.WriteFile(output, source, 0644); err != nil {
+		log.Fatalf("error writing to %s: %v", output, err)
+	}
+}
+
+// accessorType returns the name of the typed accessor matching the value produced by Decoder.DecodeByDefinition.
+// A zero multiplier (the column is missing on the wiki) still gives FloatElement: the decoder returns the value
+// unscaled and FloatElement.Get converts it, so such models do not downgrade the accessor of other models
+func accessorType(m *IOElementDefinition) string {
+	switch m.Type {
+	case IOElementHEX, IOElementASCII:
+		return "StringElement"
+	case IOElementUnsigned:
+		if m.NumBytes == 1 && m.Min == 0 && m.Max == 1 {
+			return "BoolElement"
+		}
+		if m.Multiplier != 1.0 {
+			return "FloatElement"
+		}
+		return "UintElement"
+	case IOElementSigned:
+		if m.Multiplier != 1.0 {
+			return "FloatElement"
+		}
+		return "IntElement"
+	}
+	return "Element"
+}
+
+// toGoIdentifier converts an I/O element name to an exported Go identifier, "Total Odometer" -> "TotalOdometer"
+func toGoIdentifier(name string) string {
+	words := regexp.MustCompile(`[^A-Za-z0-9]+`).Split(name, -1)
+	var sb strings.Builder
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	res := sb.String()
+	if res == "" || (res[0] >= '0' && res[0] <= '9') {
+		res = "IO" + res
+	}
+	return res
+}
+
 func dumpCsv(data []*IOElementDefinition, path string) {
 	f, err := os.Create(path)
 	if err != nil {
@@ -246,6 +745,54 @@ func dumpCsv(data []*IOElementDefinition, path string) {
 	}
 }
 
+// dumpJson writes definitions as a json array or, if lines is set, as json lines (one definition per line)
+func dumpJson(data []*IOElementDefinition, path string, lines bool) {
+	var buf bytes.Buffer
+	definitions := cleanDefinitions(data)
+	if lines {
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		for i := range definitions {
+			if err := encoder.Encode(&definitions[i]); err != nil {
+				log.Fatalf("error marshalling json: %v", err)
+			}
+		}
+	} else {
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(definitions); err != nil {
+			log.Fatalf("error marshalling json: %v", err)
+		}
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		log.Fatalf("error writing to %s: %v", path, err)
+	}
+}
+
+func readJson(path string) []*IOElementDefinition {
+	res, err := readJsonDump(path)
+	if err != nil {
+		log.Fatalf("error reading %s: %v", path, err)
+	}
+	return res
+}
+
+// cleanDefinitions returns copies of definitions without empty model and group names
+func cleanDefinitions(data []*IOElementDefinition) []IOElementDefinition {
+	clean := func(list StringSlice) StringSlice {
+		return sliceFilter(list, func(it string) bool { return it != "" })
+	}
+	res := make([]IOElementDefinition, 0, len(data))
+	for _, it := range data {
+		def := *it
+		def.SupportedModels = clean(def.SupportedModels)
+		def.Groups = clean(def.Groups)
+		res = append(res, def)
+	}
+	return res
+}
+
 func readCsv(path string) []*IOElementDefinition {
 	f, err := os.Open(path)
 	if err != nil {
@@ -261,6 +808,37 @@ func readCsv(path string) []*IOElementDefinition {
 }
 
 func collectDefinitionsNetwork(modelNames []string) []*IOElementDefinition {
+	return collectDefinitions(modelNames, options.ParseNetwork.NoFollow, modelNameToUrl, loadPage)
+}
+
+// collectDefinitionsHtml collects definitions from a directory of saved wiki pages,
+// the file name of a page must start with the model name: "FMB920.html", "FMB920_Teltonika_Data_Sending_Parameters_ID.html".
+// If no models are specified, all pages in the directory are processed
+func collectDefinitionsHtml(dir string, modelNames []string) []*IOElementDefinition {
+	pages, err := listHtmlPages(dir)
+	if err != nil {
+		log.Fatalf("error reading html directory %s: %v", dir, err)
+	}
+	if len(modelNames) == 0 {
+		for model := range pages {
+			modelNames = append(modelNames, model)
+		}
+		slices.Sort(modelNames)
+	}
+
+	modelNameToFile := func(modelName string) string {
+		return pages[modelName]
+	}
+
+	return collectDefinitions(modelNames, options.ParseHtml.NoFollow, modelNameToFile, loadFile)
+}
+
+func collectDefinitions(
+	modelNames []string,
+	noFollow bool,
+	locate func(modelName string) string,
+	load func(location string, onPage func(data string)),
+) []*IOElementDefinition {
 	result := make([]*IOElementDefinition, 0)
 
 	mergeSupportedModels := func(a *IOElementDefinition, b *IOElementDefinition) {
@@ -270,7 +848,7 @@ func collectDefinitionsNetwork(modelNames []string) []*IOElementDefinition {
 		b.SupportedModels = res
 	}
 
-	loadPagesRecursively(modelNames, func(current *IOElementDefinition) {
+	loadPagesRecursively(modelNames, noFollow, locate, load, func(current *IOElementDefinition) {
 		toReplace := make([]int, 0)
 		for i, definition := range result {
 			if definition.Id != current.Id {
@@ -459,19 +1037,30 @@ func modelNameToUrl(modelName string) string {
 }
 
 // Load pages recursively by parsing "HW Support" column and adding links to another models to the stack
-func loadPagesRecursively(modelNames []string, onDefinition func(it *IOElementDefinition)) {
-	urlsToProcess := make([]string, 0)
+// locate returns the page location (url or file path) for the model name, empty string if there is no page
+func loadPagesRecursively(
+	modelNames []string,
+	noFollow bool,
+	locate func(modelName string) string,
+	load func(location string, onPage func(data string)),
+	onDefinition func(it *IOElementDefinition),
+) {
+	pagesToProcess := make([]string, 0)
 	for _, it := range modelNames {
-		urlsToProcess = append(urlsToProcess, modelNameToUrl(it))
+		if location := locate(it); location != "" {
+			pagesToProcess = append(pagesToProcess, location)
+		} else {
+			log.Printf("No page found for model %s", it)
+		}
 	}
 
-	for i := 0; i < len(urlsToProcess); i++ {
-		log.Printf("Parsing %s, %d pages remaining", urlsToProcess[i], len(urlsToProcess)-1-i)
+	for i := 0; i < len(pagesToProcess); i++ {
+		log.Printf("Parsing %s, %d pages remaining", pagesToProcess[i], len(pagesToProcess)-1-i)
 
-		loadPage(urlsToProcess[i], func(page string) {
+		load(pagesToProcess[i], func(page string) {
 			modelName := ""
 			processPageTable(page, func(document *goquery.Document, tds *goquery.Selection) {
-				if !options.ParseNetwork.NoFollow {
+				if !noFollow {
 					otherPages := tds.Eq(9).Find("a").Map(func(_ int, it *goquery.Selection) string {
 						link := it.AttrOr("href", "")
 						if link == "" {
@@ -485,17 +1074,17 @@ func loadPagesRecursively(modelNames []string, onDefinition func(it *IOElementDe
 						title := pUrl.Query().Get("title")
 						if pUrl.Path == "/index.php" {
 							if title != "" {
-								return modelNameToUrl(title)
+								return locate(title)
 							}
 							return ""
 						}
 						p := strings.Split(pUrl.Path, "/")
-						return modelNameToUrl(p[len(p)-1])
+						return locate(p[len(p)-1])
 					})
 
 					for _, link := range otherPages {
-						if link != "" && !slices.Contains(urlsToProcess, link) {
-							urlsToProcess = append(urlsToProcess, link)
+						if link != "" && !slices.Contains(pagesToProcess, link) {
+							pagesToProcess = append(pagesToProcess, link)
 						}
 					}
 				}
@@ -517,11 +1106,51 @@ func loadPagesRecursively(modelNames []string, onDefinition func(it *IOElementDe
 	}
 }
 
+// listHtmlPages returns a map of model names to saved page paths,
+// the model name is the leading alphanumeric part of the file name
+func listHtmlPages(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	modelRe := regexp.MustCompile(`^[A-Za-z0-9]+`)
+	pages := map[string]string{}
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".html" && ext != ".htm") {
+			continue
+		}
+		model := modelRe.FindString(entry.Name())
+		if model == "" {
+			continue
+		}
+		if other, ok := pages[model]; ok {
+			log.Printf("Multiple pages found for model %s: %s, %s", model, other, entry.Name())
+			continue
+		}
+		pages[model] = filepath.Join(dir, entry.Name())
+	}
+	return pages, nil
+}
+
+func loadFile(path string, onPage func(data string)) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("Failed to read page %s: %v", path, err)
+		return
+	}
+	onPage(string(data))
+}
+
 func loadPage(url string, onPage func(data string)) {
+	loadPageCached(string(options.ParseNetwork.Cache), options.ParseNetwork.NoCache, url, onPage)
+}
+
+func loadPageCached(cache string, noCache bool, url string, onPage func(data string)) {
 	var decodedMap = make(map[string][]byte)
-	if !options.ParseNetwork.NoCache {
-		if _, err := os.Stat(string(options.ParseNetwork.Cache)); !errors.Is(err, os.ErrNotExist) {
-			f, err := os.Open(string(options.ParseNetwork.Cache))
+	if !noCache {
+		if _, err := os.Stat(cache); !errors.Is(err, os.ErrNotExist) {
+			f, err := os.Open(cache)
 			if err != nil {
 				log.Fatal("Failed to open cache file", err)
 			}
@@ -550,8 +1179,8 @@ func loadPage(url string, onPage func(data string)) {
 			}
 			decodedMap[url] = data
 		}
-		if !options.ParseNetwork.NoCache {
-			f, err := os.Create(string(options.ParseNetwork.Cache))
+		if !noCache {
+			f, err := os.Create(cache)
 			defer func() { _ = f.Close() }()
 
 			if err = gob.NewEncoder(f).Encode(decodedMap); err != nil {
@@ -778,40 +1407,1270 @@ func normalize(it *IOElementDefinition) {
 	}
 }
 
-// Utils
+// Configuration parameters
 
-func asText(it *goquery.Selection) string {
-	str := strings.Trim(it.Text(), " \n\t")
-	if str == "-" || str == "–" {
-		return ""
-	}
-	ws := regexp.MustCompile(`[ \t  ]+`)
-	res := strings.Trim(ws.ReplaceAllString(str, " "), " \n\t")
+var paramTypeNames = []string{"Uint8", "Uint16", "Uint32", "Uint64", "Int8", "Int16", "Int32", "Int64", "Double", "String"}
 
-	if len(res) > 2 && strings.Count(res, `"`) == 2 && string(res[0]) == `"` && string(res[len(res)-1]) == `"` {
-		return res[1 : len(res)-2]
-	}
-	return res
-}
+const ParamString ParamType = 9
 
-func ignoreError[T any](val T, _ error) T {
-	return val
+func (r *ParamType) MarshalCSV() (string, error) {
+	if int(*r) < len(paramTypeNames) {
+		return paramTypeNames[*r], nil
+	}
+	return "", fmt.Errorf("unknown parameter type: %v", *r)
 }
 
-func sliceAll[T any](val []T, check func(T) bool) bool {
-	for _, v := range val {
-		if !check(v) {
-			return false
+func (r *ParamType) UnmarshalCSV(csv string) error {
+	for i, name := range paramTypeNames {
+		if strings.EqualFold(name, csv) {
+			*r = ParamType(i)
+			return nil
 		}
 	}
-	return true
+	return fmt.Errorf("unknown parameter type: %v", csv)
 }
 
-func sliceAny[T any](val []T, check func(T) bool) bool {
-	for _, v := range val {
-		if check(v) {
-			return true
-		}
+// parseParamType converts the wiki value type ("Uint8", "U16", "Signed int32", "Float", "String" ...) to ParamType
+func parseParamType(raw string) (ParamType, bool) {
+	name := strings.ReplaceAll(strings.ToLower(raw), " ", "")
+	aliases := map[string]string{
+		"u8": "uint8", "u16": "uint16", "u32": "uint32", "u64": "uint64",
+		"i8": "int8", "i16": "int16", "i32": "int32", "i64": "int64",
+		"signedint8": "int8", "signedint16": "int16", "signedint32": "int32", "signedint64": "int64",
+		"unsignedint8": "uint8", "unsignedint16": "uint16", "unsignedint32": "uint32", "unsignedint64": "uint64",
+		"float": "double", "char": "string", "ascii": "string", "text": "string",
+	}
+	if alias, ok := aliases[name]; ok {
+		name = alias
+	}
+	var res ParamType
+	if err := res.UnmarshalCSV(name); err != nil {
+		return ParamString, false
+	}
+	return res, true
+}
+
+func runParams() {
+	var res []*ParamDefinition
+	var csvOutput, jsonOutput flags.Filename
+	switch parser.Command.Active.Name {
+	case "load-params-net":
+		opts := options.ParamsNet
+		locate := func(modelName string) string {
+			return strings.ReplaceAll(opts.UrlPattern, "{model}", modelName)
+		}
+		load := func(url string, onPage func(data string)) {
+			loadPageCached(string(opts.Cache), opts.NoCache, url, onPage)
+		}
+		res = collectParams(opts.Models, locate, load)
+		csvOutput, jsonOutput = opts.CsvOutput, opts.JsonOutput
+	case "parse-params-html":
+		res = collectParamsHtml(string(options.ParamsHtml.Arg.InputDir), options.ParamsHtml.Models)
+		csvOutput, jsonOutput = options.ParamsHtml.CsvOutput, options.ParamsHtml.JsonOutput
+	case "load-params-csv":
+		f, err := os.Open(string(options.ParamsCsv.Arg.InputFile))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = gocsv.Unmarshal(f, &res); err != nil {
+			log.Fatalf("error marshalling csv: %v", err)
+		}
+		_ = f.Close()
+		jsonOutput = options.ParamsCsv.JsonOutput
+	}
+
+	if csvOutput != "" {
+		f, err := os.Create(string(csvOutput))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = gocsv.Marshal(res, f); err != nil {
+			log.Fatalf("error marshalling csv: %v", err)
+		}
+		_ = f.Close()
+	}
+	if jsonOutput != "" {
+		data, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(string(jsonOutput), append(data, '\n'), 0644); err != nil {
+			log.Fatalf("error writing to %s: %v", jsonOutput, err)
+		}
+	}
+	if !options.NoGen {
+		generateParams(res, string(options.ParamsOutput))
+	}
+}
+
+// collectParamsHtml collects parameters from a directory of saved wiki pages, one page per model ("FMB920_Parameter_list.html").
+// If no models are specified, all pages in the directory are processed
+func collectParamsHtml(dir string, modelNames []string) []*ParamDefinition {
+	pages, err := listHtmlPages(dir)
+	if err != nil {
+		log.Fatalf("error reading html directory %s: %v", dir, err)
+	}
+	if len(modelNames) == 0 {
+		for model := range pages {
+			modelNames = append(modelNames, model)
+		}
+		slices.Sort(modelNames)
+	}
+	return collectParams(modelNames, func(modelName string) string { return pages[modelName] }, loadFile)
+}
+
+// collectParams parses parameter list pages of the models, equal definitions of different models are merged
+func collectParams(
+	modelNames []string,
+	locate func(modelName string) string,
+	load func(location string, onPage func(data string)),
+) []*ParamDefinition {
+	res := make([]*ParamDefinition, 0)
+	for _, modelName := range modelNames {
+		location := locate(modelName)
+		if location == "" {
+			log.Printf("No page found for model %s", modelName)
+			continue
+		}
+		log.Printf("Parsing %s", location)
+		load(location, func(page string) {
+			for _, it := range parseParamsPage(modelName, page) {
+				res = mergeParam(res, it)
+			}
+		})
+	}
+	slices.SortStableFunc(res, func(a, b *ParamDefinition) int {
+		if a.Id != b.Id {
+			return int(a.Id) - int(b.Id)
+		}
+		return strings.Compare(a.SupportedModels[0], b.SupportedModels[0])
+	})
+	return res
+}
+
+// mergeParam adds the definition models to an equal definition, or appends the definition
+func mergeParam(data []*ParamDefinition, def *ParamDefinition) []*ParamDefinition {
+	for _, it := range data {
+		a, b := *it, *def
+		a.SupportedModels, b.SupportedModels = nil, nil
+		if cmp.Equal(a, b) {
+			it.SupportedModels = unique(append(it.SupportedModels, def.SupportedModels...))
+			slices.Sort(it.SupportedModels)
+			return data
+		}
+	}
+	return append(data, def)
+}
+
+// parseParamsPage parses parameter tables of the page. Columns are detected by the header names,
+// tables without "id" and "name" columns are skipped. The group is the closest heading before the table
+func parseParamsPage(modelName string, page string) []*ParamDefinition {
+	document, err := goquery.NewDocumentFromReader(bytes.NewBufferString(page))
+	if err != nil {
+		log.Printf("Failed to parse the HTML document %v", err)
+		return nil
+	}
+
+	res := make([]*ParamDefinition, 0)
+	group := ""
+	document.Find(".mw-parser-output").First().Find("h2, h3, h4, table").Each(func(_ int, it *goquery.Selection) {
+		if goquery.NodeName(it) != "table" {
+			group = asText(it.Find(".mw-headline").First())
+			if group == "" {
+				group = asText(it)
+			}
+			return
+		}
+
+		columns := map[string]int{}
+		it.Find("tr").First().Find("th, td").Each(func(i int, th *goquery.Selection) {
+			words := strings.Fields(strings.ToLower(asText(th)))
+			key := ""
+			switch {
+			case slices.Contains(words, "id"):
+				key = "id"
+			case slices.Contains(words, "name"):
+				key = "name"
+			case slices.Contains(words, "type"):
+				key = "type"
+			case len(words) > 0 && strings.HasPrefix(words[0], "min"):
+				key = "min"
+			case len(words) > 0 && strings.HasPrefix(words[0], "max"):
+				key = "max"
+			case slices.Contains(words, "default") || slices.Contains(words, "recommended"):
+				key = "default"
+			case slices.Contains(words, "description") || slices.Contains(words, "comment") || slices.Contains(words, "comments"):
+				key = "description"
+			}
+			if _, ok := columns[key]; key != "" && !ok {
+				columns[key] = i
+			}
+		})
+		if _, ok := columns["id"]; !ok {
+			return
+		}
+		if _, ok := columns["name"]; !ok {
+			return
+		}
+
+		it.Find("tr").Each(func(_ int, tr *goquery.Selection) {
+			tds := tr.Find("td")
+			if tds.Size() == 0 {
+				return
+			}
+			tds.Find("br").ReplaceWithHtml("\n")
+			cell := func(key string) string {
+				if i, ok := columns[key]; ok && i < tds.Size() {
+					return asText(tds.Eq(i))
+				}
+				return ""
+			}
+
+			id, err := strconv.ParseUint(cell("id"), 10, 32)
+			if err != nil {
+				log.Printf("[%s] invalid parameter id '%s', skipping", modelName, cell("id"))
+				return
+			}
+			def := &ParamDefinition{
+				Id:              uint32(id),
+				Name:            cell("name"),
+				Default:         cell("default"),
+				Description:     cell("description"),
+				SupportedModels: StringSlice{modelName},
+				Groups:          StringSlice{},
+			}
+			if group != "" {
+				def.Groups = StringSlice{group}
+			}
+			var ok bool
+			if def.Type, ok = parseParamType(cell("type")); !ok && cell("type") != "" {
+				log.Printf("[%d] unknown value type '%s', set to string", id, cell("type"))
+			}
+			for key, value := range map[string]*float64{"min": &def.Min, "max": &def.Max} {
+				if raw := cell(key); raw != "" {
+					if *value, err = strconv.ParseFloat(raw, 64); err != nil {
+						log.Printf("[%d] unable to parse %s '%s' as float64, set to 0", id, key, raw)
+					}
+				}
+			}
+			res = append(res, def)
+		})
+	})
+	return res
+}
+
+// generateParams writes parameter definitions as a go slice literal, pass it to params.NewCatalogue
+func generateParams(data []*ParamDefinition, output string) {
+	wrapStr := func(s interface{}) string {
+		str, _ := json.Marshal(s)
+		return string(str)
+	}
+	wrapList := func(list StringSlice) string {
+		res := make([]string, 0)
+		for _, it := range list {
+			if it != "" {
+				res = append(res, wrapStr(it))
+			}
+		}
+		return "[]string{" + strings.Join(res, ", ") + "}"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Code generated by io_elements_gen.go; DO NOT EDIT.\n\n")
+	sb.WriteString("package " + options.GenPkgName + "\n\n")
+	prefix := ""
+	if !options.GenInternal {
+		sb.WriteString(`import "github.com/alim-zanibekov/teltonika/params"` + "\n\n")
+		prefix = "params."
+	}
+	sb.WriteString("var ParamDefinitions = []" + prefix + "ParamDefinition{\n")
+	for _, it := range data {
+		typeName, err := it.Type.MarshalCSV()
+		if err != nil {
+			log.Fatal(err)
+		}
+		sb.WriteString(fmt.Sprintf("\t{%d, %s, %s, %s, %s, %s, %s, %s, %s},\n",
+			it.Id, wrapStr(it.Name), prefix+"Param"+typeName,
+			strconv.FormatFloat(it.Min, 'f', -1, 64),
+			strconv.FormatFloat(it.Max, 'f', -1, 64),
+			wrapStr(it.Default), wrapStr(it.Description),
+			wrapList(it.SupportedModels), wrapList(it.Groups),
+		))
+	}
+	sb.WriteString("}\n")
+
+	if err := os.WriteFile(output, []byte(sb.String()), 0644); err != nil {
+		log.Fatalf("error writing to %s: %v", output, err)
+	}
+}
+
+// Patches
+
+// PatchFile declarative corrections applied to the loaded definitions in order
+type PatchFile struct {
+	Patches []Patch `json:"patches"`
+}
+
+// Patch modifies, deletes or adds a definition.
+// Id selects definitions, Models (optional) limits the patch to the listed models - if a definition
+// is supported by other models too, it is split and only the copy for the listed models is patched.
+// Match (optional) applies the patch only to definitions whose fields are equal to the given values
+type Patch struct {
+	Comment string       `json:"comment,omitempty"`
+	Id      *uint16      `json:"id,omitempty"`
+	Models  []string     `json:"models,omitempty"`
+	Match   *PatchFields `json:"match,omitempty"`
+	Set     *PatchFields `json:"set,omitempty"`
+	Delete  bool         `json:"delete,omitempty"`
+	Add     *PatchFields `json:"add,omitempty"`
+}
+
+// PatchFields definition fields, nil fields are ignored. Type is one of "Signed", "Unsigned", "Hex", "ASCII"
+type PatchFields struct {
+	Id              *uint16  `json:"id,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	NumBytes        *int     `json:"numBytes,omitempty"`
+	Type            *string  `json:"type,omitempty"`
+	Min             *float64 `json:"min,omitempty"`
+	Max             *float64 `json:"max,omitempty"`
+	Multiplier      *float64 `json:"multiplier,omitempty"`
+	Units           *string  `json:"units,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	SupportedModels []string `json:"supportedModels,omitempty"`
+	Groups          []string `json:"groups,omitempty"`
+}
+
+// PatchResult describes the effect of a patch
+type PatchResult struct {
+	Index    int    `json:"index"`
+	Comment  string `json:"comment,omitempty"`
+	Action   string `json:"action"`
+	Id       uint16 `json:"id"`
+	Affected int    `json:"affected"`
+	Error    string `json:"error,omitempty"`
+}
+
+func applyPatchFile(data []*IOElementDefinition, path string) ([]*IOElementDefinition, []PatchResult) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("error reading patch file %s: %v", path, err)
+	}
+	var file PatchFile
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(&file); err != nil {
+		log.Fatalf("error parsing patch file %s: %v", path, err)
+	}
+	return applyPatches(data, file.Patches)
+}
+
+func applyPatches(data []*IOElementDefinition, patches []Patch) ([]*IOElementDefinition, []PatchResult) {
+	report := make([]PatchResult, 0, len(patches))
+	for i, patch := range patches {
+		var result PatchResult
+		data, result = applyPatch(data, patch)
+		result.Index = i
+		result.Comment = patch.Comment
+		report = append(report, result)
+	}
+	slices.SortStableFunc(data, func(a, b *IOElementDefinition) int {
+		return int(a.Id) - int(b.Id)
+	})
+	return data, report
+}
+
+func applyPatch(data []*IOElementDefinition, patch Patch) ([]*IOElementDefinition, PatchResult) {
+	if patch.Add != nil {
+		result := PatchResult{Action: "add"}
+		def, err := newDefinitionFromPatch(patch.Add)
+		if err != nil {
+			result.Error = err.Error()
+			return data, result
+		}
+		result.Id = def.Id
+		result.Affected = 1
+		return append(data, def), result
+	}
+
+	if patch.Id == nil {
+		return data, PatchResult{Action: "unknown", Error: "patch must specify either id or add"}
+	}
+	result := PatchResult{Action: "set", Id: *patch.Id}
+	if patch.Delete {
+		result.Action = "delete"
+	} else if patch.Set == nil {
+		result.Error = "patch must specify set, delete or add"
+		return data, result
+	}
+
+	res := make([]*IOElementDefinition, 0, len(data))
+	for _, def := range data {
+		if def.Id != *patch.Id || (patch.Match != nil && !matchPatchFields(def, patch.Match)) {
+			res = append(res, def)
+			continue
+		}
+
+		target := def
+		if len(patch.Models) > 0 {
+			selected := make([]string, 0)
+			rest := make([]string, 0)
+			for _, model := range def.SupportedModels {
+				if slices.Contains(patch.Models, model) {
+					selected = append(selected, model)
+				} else {
+					rest = append(rest, model)
+				}
+			}
+			if len(selected) == 0 {
+				res = append(res, def)
+				continue
+			}
+			if len(rest) > 0 {
+				other := *def
+				other.SupportedModels = rest
+				res = append(res, &other)
+				copied := *def
+				target = &copied
+				target.SupportedModels = selected
+			}
+		}
+
+		result.Affected++
+		if patch.Delete {
+			continue
+		}
+		if err := setPatchFields(target, patch.Set); err != nil {
+			result.Error = err.Error()
+		}
+		res = append(res, target)
+	}
+	return res, result
+}
+
+func newDefinitionFromPatch(fields *PatchFields) (*IOElementDefinition, error) {
+	if fields.Id == nil || fields.Name == nil || fields.NumBytes == nil || fields.Type == nil {
+		return nil, fmt.Errorf("added definition must specify id, name, numBytes and type")
+	}
+	def := &IOElementDefinition{Multiplier: 1, SupportedModels: StringSlice{}, Groups: StringSlice{}}
+	if err := setPatchFields(def, fields); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+func setPatchFields(def *IOElementDefinition, fields *PatchFields) error {
+	if fields.Type != nil {
+		if err := def.Type.UnmarshalCSV(*fields.Type); err != nil {
+			return err
+		}
+	}
+	if fields.Id != nil {
+		def.Id = *fields.Id
+	}
+	if fields.Name != nil {
+		def.Name = *fields.Name
+	}
+	if fields.NumBytes != nil {
+		def.NumBytes = *fields.NumBytes
+	}
+	if fields.Min != nil {
+		def.Min = *fields.Min
+	}
+	if fields.Max != nil {
+		def.Max = *fields.Max
+	}
+	if fields.Multiplier != nil {
+		def.Multiplier = *fields.Multiplier
+	}
+	if fields.Units != nil {
+		def.Units = *fields.Units
+	}
+	if fields.Description != nil {
+		def.Description = *fields.Description
+	}
+	if fields.SupportedModels != nil {
+		def.SupportedModels = append(StringSlice{}, fields.SupportedModels...)
+		slices.Sort(def.SupportedModels)
+	}
+	if fields.Groups != nil {
+		def.Groups = append(StringSlice{}, fields.Groups...)
+		slices.Sort(def.Groups)
+	}
+	return nil
+}
+
+func matchPatchFields(def *IOElementDefinition, fields *PatchFields) bool {
+	if fields.Type != nil {
+		var elementType ElementType
+		if err := elementType.UnmarshalCSV(*fields.Type); err != nil || elementType != def.Type {
+			return false
+		}
+	}
+	return (fields.Name == nil || *fields.Name == def.Name) &&
+		(fields.NumBytes == nil || *fields.NumBytes == def.NumBytes) &&
+		(fields.Min == nil || *fields.Min == def.Min) &&
+		(fields.Max == nil || *fields.Max == def.Max) &&
+		(fields.Multiplier == nil || *fields.Multiplier == def.Multiplier) &&
+		(fields.Units == nil || *fields.Units == def.Units) &&
+		(fields.Description == nil || *fields.Description == def.Description)
+}
+
+func printPatchReport(path string, report []PatchResult) {
+	applied := 0
+	for _, it := range report {
+		status := fmt.Sprintf("applied to %d definition(s)", it.Affected)
+		if it.Error != "" {
+			status = "error: " + it.Error
+		} else if it.Affected == 0 {
+			status = "no effect"
+		} else {
+			applied++
+		}
+		comment := ""
+		if it.Comment != "" {
+			comment = " (" + it.Comment + ")"
+		}
+		log.Printf("[%s] patch #%d %s id %d%s: %s", path, it.Index, it.Action, it.Id, comment, status)
+	}
+	log.Printf("[%s] %d of %d patches took effect", path, applied, len(report))
+}
+
+// Diff
+
+// Changelog difference between two definition sets
+type Changelog struct {
+	Old      string     `json:"old"`
+	New      string     `json:"new"`
+	Added    int        `json:"added"`
+	Removed  int        `json:"removed"`
+	Changed  int        `json:"changed"`
+	Breaking bool       `json:"breaking"`
+	Changes  []IdChange `json:"changes"`
+}
+
+// IdChange changes of the definitions with the same id.
+// Kind is "added", "removed" (the id is not present in one of the sets) or "changed"
+type IdChange struct {
+	Id            uint16        `json:"id"`
+	Name          string        `json:"name"`
+	Kind          string        `json:"kind"`
+	Breaking      bool          `json:"breaking"`
+	AddedModels   []string      `json:"addedModels,omitempty"`
+	RemovedModels []string      `json:"removedModels,omitempty"`
+	Fields        []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange a field value change for the listed models.
+// Changes of numBytes, type and multiplier are breaking, values are decoded differently after them
+type FieldChange struct {
+	Field    string   `json:"field"`
+	Old      string   `json:"old"`
+	New      string   `json:"new"`
+	Breaking bool     `json:"breaking"`
+	Models   []string `json:"models"`
+}
+
+var breakingFields = map[string]bool{"numBytes": true, "type": true, "multiplier": true}
+
+func runDiff() {
+	oldPath, newPath := string(options.Diff.Arg.Old), string(options.Diff.Arg.New)
+	changelog := diffDefinitions(readDefinitions(oldPath), readDefinitions(newPath))
+	changelog.Old, changelog.New = oldPath, newPath
+
+	fmt.Print(formatChangelog(changelog))
+
+	if options.Diff.JsonOutput != "" {
+		data, err := json.MarshalIndent(changelog, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(string(options.Diff.JsonOutput), data, 0644); err != nil {
+			log.Fatalf("error writing to %s: %v", options.Diff.JsonOutput, err)
+		}
+	}
+	if options.Diff.FailOnBreaking && changelog.Breaking {
+		os.Exit(2)
+	}
+}
+
+// readDefinitions reads definitions from a generated go file, csv or json file (by the file extension)
+func readDefinitions(path string) []*IOElementDefinition {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCsv(path)
+	case ".go":
+		res, err := readGoDump(path)
+		if err != nil {
+			log.Fatalf("error reading %s: %v", path, err)
+		}
+		return res
+	case ".json", ".jsonl", ".gz":
+		return readJson(path)
+	}
+	log.Fatalf("unknown definitions file format: %s", path)
+	return nil
+}
+
+var goDumpFields = []string{
+	"Id", "Name", "NumBytes", "Type", "Min", "Max", "Multiplier", "Units", "Description", "SupportedModels", "Groups",
+}
+
+// readJsonDump reads a json array of definitions or json lines, gzip compressed if the file has .gz extension
+func readJsonDump(path string) ([]*IOElementDefinition, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var reader io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = gz.Close() }()
+		reader = gz
+	}
+
+	buffered := bufio.NewReader(reader)
+	first, err := peekNonSpace(buffered)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(buffered)
+	res := make([]*IOElementDefinition, 0)
+	if first == '[' {
+		if err = decoder.Decode(&res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	for line := 1; ; line++ {
+		def := &IOElementDefinition{}
+		if err = decoder.Decode(def); err == io.EOF {
+			return res, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("definition %d: %v", line, err)
+		}
+		res = append(res, def)
+	}
+}
+
+func peekNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		c, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			return c, reader.UnreadByte()
+		}
+	}
+}
+
+// readGoDump parses the ioElementDefinitions variable of a file written by generate,
+// for the embed format the embedded data file is read
+func readGoDump(path string) ([]*IOElementDefinition, error) {
+	file, err := goparser.ParseFile(token.NewFileSet(), path, nil, goparser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if embed := strings.TrimPrefix(comment.Text, "//go:embed "); embed != comment.Text {
+				return readJsonDump(filepath.Join(filepath.Dir(path), strings.TrimSpace(embed)))
+			}
+		}
+	}
+
+	var list *ast.CompositeLit
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || list != nil {
+			return list == nil
+		}
+		for i, name := range spec.Names {
+			if name.Name == "ioElementDefinitions" && i < len(spec.Values) {
+				list, _ = spec.Values[i].(*ast.CompositeLit)
+			}
+		}
+		return false
+	})
+	if list == nil {
+		return nil, fmt.Errorf("ioElementDefinitions variable not found")
+	}
+
+	res := make([]*IOElementDefinition, 0, len(list.Elts))
+	for _, elt := range list.Elts {
+		lit, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected definition expression %T", elt)
+		}
+		values := map[string]ast.Expr{}
+		for i, it := range lit.Elts {
+			if kv, ok := it.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok {
+					values[key.Name] = kv.Value
+				}
+			} else if i < len(goDumpFields) {
+				values[goDumpFields[i]] = it
+			}
+		}
+		def := &IOElementDefinition{SupportedModels: StringSlice{}, Groups: StringSlice{}}
+		if err = setGoDumpFields(def, values); err != nil {
+			return nil, fmt.Errorf("definition at %v: %v", lit.Pos(), err)
+		}
+		res = append(res, def)
+	}
+	return res, nil
+}
+
+func setGoDumpFields(def *IOElementDefinition, values map[string]ast.Expr) error {
+	number := func(field string) (float64, error) {
+		expr := values[field]
+		if expr == nil {
+			return 0, nil
+		}
+		sign := 1.0
+		if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+			sign, expr = -1, unary.X
+		}
+		lit, ok := expr.(*ast.BasicLit)
+		if !ok || (lit.Kind != token.INT && lit.Kind != token.FLOAT) {
+			return 0, fmt.Errorf("%s: number expected", field)
+		}
+		v, err := strconv.ParseFloat(lit.Value, 64)
+		return sign * v, err
+	}
+	str := func(field string) (string, error) {
+		if values[field] == nil {
+			return "", nil
+		}
+		lit, ok := values[field].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return "", fmt.Errorf("%s: string expected", field)
+		}
+		return strconv.Unquote(lit.Value)
+	}
+	strList := func(field string) (StringSlice, error) {
+		res := StringSlice{}
+		if values[field] == nil {
+			return res, nil
+		}
+		lit, ok := values[field].(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("%s: []string expected", field)
+		}
+		for _, it := range lit.Elts {
+			basic, ok := it.(*ast.BasicLit)
+			if !ok {
+				return nil, fmt.Errorf("%s: string expected", field)
+			}
+			v, err := strconv.Unquote(basic.Value)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, v)
+		}
+		return res, nil
+	}
+
+	var typeName string
+	switch expr := values["Type"].(type) {
+	case *ast.Ident:
+		typeName = expr.Name
+	case *ast.SelectorExpr:
+		typeName = expr.Sel.Name
+	}
+	typesMap := map[string]ElementType{
+		"IOElementSigned": IOElementSigned, "IOElementUnsigned": IOElementUnsigned,
+		"IOElementHEX": IOElementHEX, "IOElementASCII": IOElementASCII,
+	}
+	elementType, ok := typesMap[typeName]
+	if !ok {
+		return fmt.Errorf("unknown element type %q", typeName)
+	}
+	def.Type = elementType
+
+	var err error
+	var id, numBytes float64
+	if id, err = number("Id"); err != nil {
+		return err
+	}
+	if numBytes, err = number("NumBytes"); err != nil {
+		return err
+	}
+	def.Id, def.NumBytes = uint16(id), int(numBytes)
+	if def.Min, err = number("Min"); err != nil {
+		return err
+	}
+	if def.Max, err = number("Max"); err != nil {
+		return err
+	}
+	if def.Multiplier, err = number("Multiplier"); err != nil {
+		return err
+	}
+	if def.Name, err = str("Name"); err != nil {
+		return err
+	}
+	if def.Units, err = str("Units"); err != nil {
+		return err
+	}
+	if def.Description, err = str("Description"); err != nil {
+		return err
+	}
+	if def.SupportedModels, err = strList("SupportedModels"); err != nil {
+		return err
+	}
+	def.Groups, err = strList("Groups")
+	return err
+}
+
+// diffDefinitions compares definitions model by model
+func diffDefinitions(oldData, newData []*IOElementDefinition) *Changelog {
+	// id -> model -> definition
+	index := func(data []*IOElementDefinition) map[uint16]map[string]*IOElementDefinition {
+		res := map[uint16]map[string]*IOElementDefinition{}
+		for _, def := range data {
+			if res[def.Id] == nil {
+				res[def.Id] = map[string]*IOElementDefinition{}
+			}
+			for _, model := range def.SupportedModels {
+				if model != "" && res[def.Id][model] == nil {
+					res[def.Id][model] = def
+				}
+			}
+		}
+		return res
+	}
+	oldIndex, newIndex := index(oldData), index(newData)
+
+	ids := make([]uint16, 0)
+	for id := range oldIndex {
+		ids = append(ids, id)
+	}
+	for id := range newIndex {
+		if oldIndex[id] == nil {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	changelog := &Changelog{Changes: make([]IdChange, 0)}
+	for _, id := range ids {
+		oldModels, newModels := oldIndex[id], newIndex[id]
+		change := IdChange{Id: id, Name: definitionsName(newModels), Kind: "changed"}
+		if change.Name == "" {
+			change.Name = definitionsName(oldModels)
+		}
+
+		fields := map[string]*FieldChange{}
+		for _, model := range sortedKeys(oldModels) {
+			newDef := newModels[model]
+			if newDef == nil {
+				change.RemovedModels = append(change.RemovedModels, model)
+				continue
+			}
+			for _, it := range compareDefinitions(oldModels[model], newDef) {
+				key := it.Field + "\x00" + it.Old + "\x00" + it.New
+				if fields[key] == nil {
+					fields[key] = &FieldChange{Field: it.Field, Old: it.Old, New: it.New, Breaking: breakingFields[it.Field]}
+					change.Fields = append(change.Fields, *fields[key])
+				}
+				fields[key].Models = append(fields[key].Models, model)
+			}
+		}
+		for i := range change.Fields {
+			it := &change.Fields[i]
+			it.Models = fields[it.Field+"\x00"+it.Old+"\x00"+it.New].Models
+			change.Breaking = change.Breaking || it.Breaking
+		}
+		for _, model := range sortedKeys(newModels) {
+			if oldModels[model] == nil {
+				change.AddedModels = append(change.AddedModels, model)
+			}
+		}
+
+		switch {
+		case len(oldModels) == 0:
+			change.Kind = "added"
+			changelog.Added++
+		case len(newModels) == 0:
+			change.Kind = "removed"
+			change.Breaking = true
+			changelog.Removed++
+		case len(change.Fields) > 0 || len(change.AddedModels) > 0 || len(change.RemovedModels) > 0:
+			change.Breaking = change.Breaking || len(change.RemovedModels) > 0
+			changelog.Changed++
+		default:
+			continue
+		}
+		changelog.Breaking = changelog.Breaking || change.Breaking
+		changelog.Changes = append(changelog.Changes, change)
+	}
+	return changelog
+}
+
+func compareDefinitions(a, b *IOElementDefinition) []FieldChange {
+	formatFloat := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	typeA, _ := a.Type.MarshalCSV()
+	typeB, _ := b.Type.MarshalCSV()
+	pairs := [][3]string{
+		{"name", a.Name, b.Name},
+		{"numBytes", strconv.Itoa(a.NumBytes), strconv.Itoa(b.NumBytes)},
+		{"type", typeA, typeB},
+		{"min", formatFloat(a.Min), formatFloat(b.Min)},
+		{"max", formatFloat(a.Max), formatFloat(b.Max)},
+		{"multiplier", formatFloat(a.Multiplier), formatFloat(b.Multiplier)},
+		{"units", a.Units, b.Units},
+		{"description", a.Description, b.Description},
+		{"groups", strings.Join(a.Groups, ", "), strings.Join(b.Groups, ", ")},
+	}
+	res := make([]FieldChange, 0)
+	for _, it := range pairs {
+		if it[1] != it[2] {
+			res = append(res, FieldChange{Field: it[0], Old: it[1], New: it[2]})
+		}
+	}
+	return res
+}
+
+func definitionsName(models map[string]*IOElementDefinition) string {
+	counts := map[string]int{}
+	name := ""
+	for _, model := range sortedKeys(models) {
+		counts[models[model].Name]++
+		if counts[models[model].Name] > counts[name] {
+			name = models[model].Name
+		}
+	}
+	return name
+}
+
+func sortedKeys(m map[string]*IOElementDefinition) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// formatModelList joins model names, long lists are shortened
+func formatModelList(models []string) string {
+	if len(models) > 6 {
+		return fmt.Sprintf("%s and %d more", strings.Join(models[:6], ", "), len(models)-6)
+	}
+	return strings.Join(models, ", ")
+}
+
+func formatChangelog(changelog *Changelog) string {
+	formatModels := formatModelList
+	breaking := func(v bool) string {
+		if v {
+			return " [breaking]"
+		}
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, it := range changelog.Changes {
+		switch it.Kind {
+		case "added":
+			sb.WriteString(fmt.Sprintf("+ %d %s (%s)\n", it.Id, it.Name, formatModels(it.AddedModels)))
+			continue
+		case "removed":
+			sb.WriteString(fmt.Sprintf("- %d %s (%s)%s\n", it.Id, it.Name, formatModels(it.RemovedModels), breaking(true)))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("~ %d %s%s\n", it.Id, it.Name, breaking(it.Breaking)))
+		for _, field := range it.Fields {
+			sb.WriteString(fmt.Sprintf("    %s: %q -> %q (%s)%s\n",
+				field.Field, field.Old, field.New, formatModels(field.Models), breaking(field.Breaking)))
+		}
+		if len(it.AddedModels) > 0 {
+			sb.WriteString(fmt.Sprintf("    models added: %s\n", formatModels(it.AddedModels)))
+		}
+		if len(it.RemovedModels) > 0 {
+			sb.WriteString(fmt.Sprintf("    models removed: %s%s\n", formatModels(it.RemovedModels), breaking(true)))
+		}
+	}
+	sb.WriteString(fmt.Sprintf("%d added, %d removed, %d changed", changelog.Added, changelog.Removed, changelog.Changed))
+	if changelog.Breaking {
+		sb.WriteString(", contains breaking changes")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// Lint
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// LintIssue a rule violation by the definitions with the id
+type LintIssue struct {
+	Id       uint16   `json:"id"`
+	Name     string   `json:"name"`
+	Rule     string   `json:"rule"`
+	Severity string   `json:"severity"`
+	Message  string   `json:"message"`
+	Models   []string `json:"models"`
+}
+
+type lintRule struct {
+	name     string
+	severity string
+	// check returns issues for the definitions with the same id, Rule and Severity are filled by the caller
+	check func(defs []*IOElementDefinition) []LintIssue
+}
+
+var lintRules = []lintRule{
+	{"invalid-width", SeverityError, perDefinition(func(def *IOElementDefinition) string {
+		if isNumeric(def) && (def.NumBytes < 1 || def.NumBytes > 8) {
+			return fmt.Sprintf("numeric element with %d bytes can't be decoded, expected 1 to 8", def.NumBytes)
+		}
+		if !isNumeric(def) && def.NumBytes == 0 {
+			return "element with 0 bytes, use -1 for variable length"
+		}
+		return ""
+	})},
+	{"min-greater-than-max", SeverityError, perDefinition(func(def *IOElementDefinition) string {
+		if def.Min > def.Max {
+			return fmt.Sprintf("min %v is greater than max %v", def.Min, def.Max)
+		}
+		return ""
+	})},
+	{"conflicting-definitions", SeverityError, lintConflictingDefinitions},
+	{"ascii-single-byte", SeverityWarning, perDefinition(func(def *IOElementDefinition) string {
+		if def.Type == IOElementASCII && def.NumBytes == 1 {
+			return "ASCII element with 1 byte, probably a numeric element"
+		}
+		return ""
+	})},
+	{"multiplier-on-string", SeverityWarning, perDefinition(func(def *IOElementDefinition) string {
+		if !isNumeric(def) && def.Multiplier != 1 {
+			typeName, _ := def.Type.MarshalCSV()
+			return fmt.Sprintf("multiplier %v is ignored for %s elements", def.Multiplier, typeName)
+		}
+		return ""
+	})},
+	{"zero-multiplier", SeverityWarning, perDefinition(func(def *IOElementDefinition) string {
+		if isNumeric(def) && def.Multiplier == 0 {
+			return "multiplier is 0 (missing in the source), values are decoded unscaled"
+		}
+		return ""
+	})},
+	{"odd-width", SeverityWarning, perDefinition(func(def *IOElementDefinition) string {
+		if isNumeric(def) && def.NumBytes > 0 && def.NumBytes <= 8 && def.NumBytes&(def.NumBytes-1) != 0 {
+			return fmt.Sprintf("numeric element with %d bytes", def.NumBytes)
+		}
+		return ""
+	})},
+	{"range-not-representable", SeverityWarning, perDefinition(func(def *IOElementDefinition) string {
+		if !isNumeric(def) || def.NumBytes < 1 || def.NumBytes > 8 {
+			return ""
+		}
+		lo, hi := 0.0, math.Pow(2, float64(8*def.NumBytes))-1
+		if def.Type == IOElementSigned {
+			lo, hi = -math.Pow(2, float64(8*def.NumBytes-1)), math.Pow(2, float64(8*def.NumBytes-1))-1
+		}
+		if def.Min < lo || def.Max > hi {
+			typeName, _ := def.Type.MarshalCSV()
+			return fmt.Sprintf("range [%v, %v] doesn't fit %s %d byte(s) [%v, %v]",
+				def.Min, def.Max, strings.ToLower(typeName), def.NumBytes, lo, hi)
+		}
+		return ""
+	})},
+	{"no-models", SeverityWarning, perDefinition(func(def *IOElementDefinition) string {
+		for _, it := range def.SupportedModels {
+			if it != "" {
+				return ""
+			}
+		}
+		return "definition is not supported by any model"
+	})},
+	{"inconsistent-definitions", SeverityWarning, lintInconsistentDefinitions},
+}
+
+func runLint() {
+	path := string(options.Lint.Arg.InputFile)
+	data := readDefinitions(path)
+	for _, patchFile := range options.Patches {
+		var report []PatchResult
+		data, report = applyPatchFile(data, string(patchFile))
+		printPatchReport(string(patchFile), report)
+	}
+
+	for _, name := range options.Lint.Disable {
+		if !slices.ContainsFunc(lintRules, func(rule lintRule) bool { return rule.name == name }) {
+			log.Fatalf("unknown lint rule: %s", name)
+		}
+	}
+	issues := lintDefinitions(data, options.Lint.Disable)
+	fmt.Print(formatLintIssues(issues))
+
+	if options.Lint.JsonOutput != "" {
+		raw, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(string(options.Lint.JsonOutput), raw, 0644); err != nil {
+			log.Fatalf("error writing to %s: %v", options.Lint.JsonOutput, err)
+		}
+	}
+	for _, it := range issues {
+		if it.Severity == SeverityError || options.Lint.FailOnWarnings {
+			os.Exit(1)
+		}
+	}
+}
+
+// lintDefinitions checks definitions against all rules except disabled, issues are sorted by id
+func lintDefinitions(data []*IOElementDefinition, disabled []string) []LintIssue {
+	byId := map[uint16][]*IOElementDefinition{}
+	ids := make([]uint16, 0)
+	for _, def := range data {
+		if byId[def.Id] == nil {
+			ids = append(ids, def.Id)
+		}
+		byId[def.Id] = append(byId[def.Id], def)
+	}
+	slices.Sort(ids)
+
+	issues := make([]LintIssue, 0)
+	for _, id := range ids {
+		for _, rule := range lintRules {
+			if slices.Contains(disabled, rule.name) {
+				continue
+			}
+			for _, it := range rule.check(byId[id]) {
+				it.Id, it.Rule, it.Severity = id, rule.name, rule.severity
+				issues = append(issues, it)
+			}
+		}
+	}
+	return issues
+}
+
+func perDefinition(check func(def *IOElementDefinition) string) func(defs []*IOElementDefinition) []LintIssue {
+	return func(defs []*IOElementDefinition) []LintIssue {
+		res := make([]LintIssue, 0)
+		for _, def := range defs {
+			if message := check(def); message != "" {
+				res = append(res, LintIssue{Name: def.Name, Message: message, Models: def.SupportedModels})
+			}
+		}
+		return res
+	}
+}
+
+// lintConflictingDefinitions reports models with several definitions of the same id, the decoder uses the first one
+func lintConflictingDefinitions(defs []*IOElementDefinition) []LintIssue {
+	res := make([]LintIssue, 0)
+	for i, a := range defs {
+		for _, b := range defs[i+1:] {
+			models := sliceFilter(a.SupportedModels, func(model string) bool {
+				return model != "" && slices.Contains(b.SupportedModels, model)
+			})
+			if len(models) > 0 {
+				res = append(res, LintIssue{
+					Name:    a.Name,
+					Message: fmt.Sprintf("%q and %q are both defined for the same models", a.Name, b.Name),
+					Models:  models,
+				})
+			}
+		}
+	}
+	return res
+}
+
+// lintInconsistentDefinitions reports elements with the same name which are encoded differently on different models,
+// usually a result of a scraping error or of a wrong merge
+func lintInconsistentDefinitions(defs []*IOElementDefinition) []LintIssue {
+	res := make([]LintIssue, 0)
+	for i, a := range defs {
+		for _, b := range defs[i+1:] {
+			if !strings.EqualFold(a.Name, b.Name) || a.Units != b.Units {
+				continue
+			}
+			if a.Type != b.Type || a.NumBytes != b.NumBytes || a.Multiplier != b.Multiplier {
+				typeA, _ := a.Type.MarshalCSV()
+				typeB, _ := b.Type.MarshalCSV()
+				res = append(res, LintIssue{
+					Name: a.Name,
+					Message: fmt.Sprintf("encoded as %s %d byte(s) x%v on %s, as %s %d byte(s) x%v on %s",
+						typeA, a.NumBytes, a.Multiplier, formatModelList(a.SupportedModels),
+						typeB, b.NumBytes, b.Multiplier, formatModelList(b.SupportedModels)),
+					Models: append(append([]string{}, a.SupportedModels...), b.SupportedModels...),
+				})
+			}
+		}
+	}
+	return res
+}
+
+func isNumeric(def *IOElementDefinition) bool {
+	return def.Type == IOElementSigned || def.Type == IOElementUnsigned
+}
+
+func formatLintIssues(issues []LintIssue) string {
+	var sb strings.Builder
+	errorsCount, warningsCount := 0, 0
+	ids := map[uint16]bool{}
+	for i, it := range issues {
+		if i == 0 || issues[i-1].Id != it.Id {
+			sb.WriteString(fmt.Sprintf("%d %s\n", it.Id, it.Name))
+		}
+		ids[it.Id] = true
+		if it.Severity == SeverityError {
+			errorsCount++
+		} else {
+			warningsCount++
+		}
+		sb.WriteString(fmt.Sprintf("  %-8s %s: %s (%s)\n", it.Severity, it.Rule, it.Message, formatModelList(it.Models)))
+	}
+	sb.WriteString(fmt.Sprintf("%d error(s), %d warning(s) in %d id(s)\n", errorsCount, warningsCount, len(ids)))
+	return sb.String()
+}
+
+// Utils
+
+func asText(it *goquery.Selection) string {
+	str := strings.Trim(it.Text(), " \n\t")
+	if str == "-" || str == "–" {
+		return ""
+	}
+	ws := regexp.MustCompile(`[ \t  ]+`)
+	res := strings.Trim(ws.ReplaceAllString(str, " "), " \n\t")
+
+	if len(res) > 2 && strings.Count(res, `"`) == 2 && string(res[0]) == `"` && string(res[len(res)-1]) == `"` {
+		return res[1 : len(res)-2]
+	}
+	return res
+}
+
+func ignoreError[T any](val T, _ error) T {
+	return val
+}
+
+func sliceAll[T any](val []T, check func(T) bool) bool {
+	for _, v := range val {
+		if !check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func sliceAny[T any](val []T, check func(T) bool) bool {
+	for _, v := range val {
+		if check(v) {
+			return true
+		}
 	}
 	return false
 }
@@ -821,6 +2680,16 @@ func overlap[T comparable](a1 []T, a2 []T) bool {
 		sliceAny(a2, func(t T) bool { return slices.Contains(a1, t) })
 }
 
+func sliceFilter[T any](slice []T, fn func(t T) bool) []T {
+	res := make([]T, 0)
+	for _, it := range slice {
+		if fn(it) {
+			res = append(res, it)
+		}
+	}
+	return res
+}
+
 func containsAll[T comparable](a1 []T, a2 []T) bool {
 	return sliceAll(a2, func(t T) bool { return slices.Contains(a1, t) })
 }
@@ -0,0 +1,561 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const htmlFixturesDir = "testdata/html"
+
+func TestParseHtmlFollowsLocalLinks(t *testing.T) {
+	res := collectDefinitionsHtml(htmlFixturesDir, []string{"FMB920"})
+
+	ids := map[uint16]*IOElementDefinition{}
+	for _, it := range res {
+		ids[it.Id] = it
+	}
+	// 12934, 12935 and 10800 are only present on the FMC650 page
+	for _, id := range []uint16{1, 9, 17, 66, 239, 256, 10800, 12934, 12935} {
+		if ids[id] == nil {
+			t.Errorf("element %d not found", id)
+		}
+	}
+	if ids[9] != nil && ids[9].Units != "V" {
+		t.Errorf("normalize was not applied, element 9 units: %s", ids[9].Units)
+	}
+	if ids[12934] != nil && ids[12934].Name != "COM1 DSM error code" {
+		t.Errorf("unexpected COM1 element name: %s", ids[12934].Name)
+	}
+	if ids[1] != nil && !cmp.Equal([]string(ids[1].SupportedModels), []string{"FMB920", "FMC650"}) {
+		t.Errorf("unexpected element 1 models: %v", ids[1].SupportedModels)
+	}
+}
+
+func TestParseHtmlNoFollow(t *testing.T) {
+	saveOptions(t)
+	options.ParseHtml.NoFollow = true
+
+	for _, it := range collectDefinitionsHtml(htmlFixturesDir, []string{"FMB920"}) {
+		if it.Id == 10800 {
+			t.Error("FMC650 page processed with --no-follow")
+		}
+	}
+}
+
+func TestParseHtmlEqualsNetwork(t *testing.T) {
+	saveOptions(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := os.ReadFile(filepath.Join(htmlFixturesDir, filepath.Base(r.URL.Path)+".html"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	options.ParseNetwork.UrlPattern = server.URL + "/view/{model}_Teltonika_Data_Sending_Parameters_ID"
+	options.ParseNetwork.NoCache = true
+
+	fromNetwork := collectDefinitionsNetwork([]string{"FMB920"})
+	fromHtml := collectDefinitionsHtml(htmlFixturesDir, []string{"FMB920"})
+	if diff := cmp.Diff(fromNetwork, fromHtml); diff != "" {
+		t.Errorf("network and html definitions differ (-network +html):\n%s", diff)
+	}
+
+	dir := t.TempDir()
+	networkCsv := filepath.Join(dir, "network.csv")
+	htmlCsv := filepath.Join(dir, "html.csv")
+	dumpCsv(fromNetwork, networkCsv)
+	dumpCsv(fromHtml, htmlCsv)
+	a, _ := os.ReadFile(networkCsv)
+	b, _ := os.ReadFile(htmlCsv)
+	if !bytes.Equal(a, b) || len(a) == 0 {
+		t.Error("network and html csv dumps differ")
+	}
+
+	options.GenPkgName = "main"
+	networkGo := filepath.Join(dir, "network.go")
+	htmlGo := filepath.Join(dir, "html.go")
+	generate(fromNetwork, networkGo)
+	generate(fromHtml, htmlGo)
+	a, _ = os.ReadFile(networkGo)
+	b, _ = os.ReadFile(htmlGo)
+	if !bytes.Equal(a, b) || !strings.Contains(string(a), "ioElementDefinitions") {
+		t.Error("network and html go dumps differ")
+	}
+}
+
+func TestApplyPatches(t *testing.T) {
+	u16 := func(v uint16) *uint16 { return &v }
+	f64 := func(v float64) *float64 { return &v }
+	str := func(v string) *string { return &v }
+	n := 2
+
+	data := []*IOElementDefinition{
+		{Id: 1, Name: "A", NumBytes: 1, Type: IOElementUnsigned, Max: 1, Multiplier: 1, SupportedModels: StringSlice{"FMB920", "FMC650"}},
+		{Id: 2, Name: "B", NumBytes: 1, Type: IOElementSigned, Min: -3, Max: -20, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+		{Id: 3, Name: "C", NumBytes: 4, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+	}
+	res, report := applyPatches(data, []Patch{
+		{Id: u16(1), Models: []string{"FMC650"}, Set: &PatchFields{Multiplier: f64(0.1), Units: str("V")}},
+		{Id: u16(2), Match: &PatchFields{Min: f64(-3), Max: f64(-20)}, Set: &PatchFields{Min: f64(-20), Max: f64(-3)}},
+		{Id: u16(2), Match: &PatchFields{Min: f64(-3)}, Set: &PatchFields{Name: str("never")}},
+		{Id: u16(3), Delete: true},
+		{Add: &PatchFields{Id: u16(0), Name: str("D"), NumBytes: &n, Type: str("Hex"), SupportedModels: []string{"FMB920"}}},
+		{Id: u16(4), Set: &PatchFields{Name: str("missing")}},
+	})
+
+	expected := []*IOElementDefinition{
+		{Id: 0, Name: "D", NumBytes: 2, Type: IOElementHEX, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}, Groups: StringSlice{}},
+		{Id: 1, Name: "A", NumBytes: 1, Type: IOElementUnsigned, Max: 1, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+		{Id: 1, Name: "A", NumBytes: 1, Type: IOElementUnsigned, Max: 1, Multiplier: 0.1, Units: "V", SupportedModels: StringSlice{"FMC650"}},
+		{Id: 2, Name: "B", NumBytes: 1, Type: IOElementSigned, Min: -20, Max: -3, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+	}
+	if diff := cmp.Diff(expected, res); diff != "" {
+		t.Errorf("unexpected patched definitions (-expected +actual):\n%s", diff)
+	}
+
+	affected := make([]int, 0, len(report))
+	for _, it := range report {
+		if it.Error != "" {
+			t.Errorf("patch #%d: unexpected error %s", it.Index, it.Error)
+		}
+		affected = append(affected, it.Affected)
+	}
+	if !cmp.Equal(affected, []int{1, 1, 0, 1, 1, 0}) {
+		t.Errorf("unexpected affected counts: %v", affected)
+	}
+
+	_, report = applyPatches(nil, []Patch{{Id: u16(1)}, {Add: &PatchFields{Name: str("E")}}, {Set: &PatchFields{}}})
+	for _, it := range report {
+		if it.Error == "" {
+			t.Errorf("patch #%d: expected an error", it.Index)
+		}
+	}
+}
+
+func TestReadGoDump(t *testing.T) {
+	saveOptions(t)
+	data := readCsv("io_elements_dump.csv")
+	output := filepath.Join(t.TempDir(), "dump.go")
+	options.GenPkgName = "main"
+	generate(data, output)
+
+	res, err := readGoDump(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(data, res); diff != "" {
+		t.Errorf("go dump differs from csv (-csv +go):\n%s", diff)
+	}
+}
+
+func TestDiffDefinitions(t *testing.T) {
+	oldData := []*IOElementDefinition{
+		{Id: 1, Name: "A", NumBytes: 1, Type: IOElementUnsigned, Max: 1, Multiplier: 1, SupportedModels: StringSlice{"FMB920", "FMC650"}},
+		{Id: 2, Name: "B", NumBytes: 2, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMB920", "FMC650"}},
+		{Id: 3, Name: "C", NumBytes: 4, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+	}
+	newData := []*IOElementDefinition{
+		{Id: 1, Name: "A", NumBytes: 1, Type: IOElementUnsigned, Max: 1, Multiplier: 1, SupportedModels: StringSlice{"FMB920", "FMC650", "FMC003"}},
+		{Id: 2, Name: "B", NumBytes: 2, Type: IOElementUnsigned, Multiplier: 0.1, Units: "V", SupportedModels: StringSlice{"FMB920"}},
+		{Id: 2, Name: "B", NumBytes: 2, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMC650"}},
+		{Id: 4, Name: "D", NumBytes: 1, Type: IOElementHEX, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+	}
+
+	changelog := diffDefinitions(oldData, newData)
+	expected := &Changelog{
+		Added: 1, Removed: 1, Changed: 2, Breaking: true,
+		Changes: []IdChange{
+			{Id: 1, Name: "A", Kind: "changed", AddedModels: []string{"FMC003"}},
+			{Id: 2, Name: "B", Kind: "changed", Breaking: true, Fields: []FieldChange{
+				{Field: "multiplier", Old: "1", New: "0.1", Breaking: true, Models: []string{"FMB920"}},
+				{Field: "units", Old: "", New: "V", Models: []string{"FMB920"}},
+			}},
+			{Id: 3, Name: "C", Kind: "removed", Breaking: true, RemovedModels: []string{"FMB920"}},
+			{Id: 4, Name: "D", Kind: "added", AddedModels: []string{"FMB920"}},
+		},
+	}
+	if diff := cmp.Diff(expected, changelog); diff != "" {
+		t.Errorf("unexpected changelog (-expected +actual):\n%s", diff)
+	}
+
+	text := formatChangelog(changelog)
+	for _, line := range []string{
+		"~ 1 A\n    models added: FMC003\n",
+		"    multiplier: \"1\" -> \"0.1\" (FMB920) [breaking]\n",
+		"- 3 C (FMB920) [breaking]\n",
+		"+ 4 D (FMB920)\n",
+		"1 added, 1 removed, 2 changed, contains breaking changes\n",
+	} {
+		if !strings.Contains(text, line) {
+			t.Errorf("changelog text does not contain %q:\n%s", line, text)
+		}
+	}
+
+	if changelog = diffDefinitions(newData, newData); len(changelog.Changes) != 0 || changelog.Breaking {
+		t.Errorf("unexpected changes between equal sets: %v", changelog.Changes)
+	}
+}
+
+func TestGenerateEmbedded(t *testing.T) {
+	saveOptions(t)
+	data := readCsv("io_elements_dump.csv")
+	output := filepath.Join(t.TempDir(), "dump.go")
+	options.GenPkgName = "main"
+	options.GenFormat = "embed"
+	options.GenModels = []string{"FMB920", "FMC650"}
+	generate(data, output)
+
+	code, _ := os.ReadFile(output)
+	if !strings.Contains(string(code), "//go:embed dump.json.gz") {
+		t.Errorf("unexpected embed directive:\n%s", code)
+	}
+	res, err := readGoDump(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := subsetModels(data, options.GenModels)
+	if diff := cmp.Diff(expected, res); diff != "" {
+		t.Errorf("embedded definitions differ (-expected +actual):\n%s", diff)
+	}
+	for _, it := range res {
+		for _, model := range it.SupportedModels {
+			if model != "FMB920" && model != "FMC650" {
+				t.Fatalf("definition %d has model %s outside of the subset", it.Id, model)
+			}
+		}
+	}
+}
+
+func TestLintDefinitions(t *testing.T) {
+	data := []*IOElementDefinition{
+		{Id: 1, Name: "A", NumBytes: 1, Type: IOElementASCII, Multiplier: 0.1, SupportedModels: StringSlice{"FMB920"}},
+		{Id: 2, Name: "B", NumBytes: 2, Type: IOElementSigned, Min: 10, Max: -10, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+		{Id: 3, Name: "C", NumBytes: 3, Type: IOElementUnsigned, Max: 1 << 24, Multiplier: 0, SupportedModels: StringSlice{"FMB920"}},
+		{Id: 4, Name: "D", NumBytes: 16, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{}},
+		{Id: 5, Name: "E", NumBytes: 1, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMB920", "FMC650"}},
+		{Id: 5, Name: "F", NumBytes: 2, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMC650"}},
+		{Id: 6, Name: "G", NumBytes: 2, Type: IOElementUnsigned, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+		{Id: 6, Name: "G", NumBytes: 2, Type: IOElementUnsigned, Multiplier: 0.1, SupportedModels: StringSlice{"FMC650"}},
+		{Id: 7, Name: "H", NumBytes: -1, Type: IOElementHEX, Multiplier: 1, SupportedModels: StringSlice{"FMB920"}},
+	}
+
+	rules := func(issues []LintIssue) []string {
+		res := make([]string, 0)
+		for _, it := range issues {
+			res = append(res, fmt.Sprintf("%d %s %s", it.Id, it.Severity, it.Rule))
+		}
+		return res
+	}
+	expected := []string{
+		"1 warning ascii-single-byte",
+		"1 warning multiplier-on-string",
+		"2 error min-greater-than-max",
+		"3 warning zero-multiplier",
+		"3 warning odd-width",
+		"3 warning range-not-representable",
+		"4 error invalid-width",
+		"4 warning no-models",
+		"5 error conflicting-definitions",
+		"6 warning inconsistent-definitions",
+	}
+	issues := lintDefinitions(data, nil)
+	if diff := cmp.Diff(expected, rules(issues)); diff != "" {
+		t.Errorf("unexpected issues (-expected +actual):\n%s", diff)
+	}
+	if !cmp.Equal(issues[8].Models, []string{"FMC650"}) {
+		t.Errorf("unexpected conflicting models: %v", issues[8].Models)
+	}
+	if text := formatLintIssues(issues); !strings.HasSuffix(text, "3 error(s), 7 warning(s) in 6 id(s)\n") {
+		t.Errorf("unexpected report summary:\n%s", text)
+	}
+
+	issues = lintDefinitions(data, []string{"odd-width", "zero-multiplier", "range-not-representable"})
+	if diff := cmp.Diff(append(expected[:3:3], expected[6:]...), rules(issues)); diff != "" {
+		t.Errorf("unexpected issues with disabled rules (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestLintCommittedDump(t *testing.T) {
+	for _, it := range lintDefinitions(readDefinitions("../ioelements/ioelements_dump.go"), nil) {
+		if it.Severity == SeverityError {
+			t.Errorf("%d %s: %s: %s", it.Id, it.Name, it.Rule, it.Message)
+		}
+	}
+}
+
+func TestJsonRoundTrip(t *testing.T) {
+	saveOptions(t)
+	dir := t.TempDir()
+	fromCsv := readCsv("io_elements_dump.csv")
+
+	jsonPath := filepath.Join(dir, "dump.json")
+	jsonlPath := filepath.Join(dir, "dump.jsonl")
+	dumpJson(fromCsv, jsonPath, false)
+	dumpJson(fromCsv, jsonlPath, true)
+	fromJson := readJson(jsonPath)
+	fromJsonl := readJson(jsonlPath)
+	if diff := cmp.Diff(fromCsv, fromJson); diff != "" {
+		t.Errorf("csv and json definitions differ (-csv +json):\n%s", diff)
+	}
+	if diff := cmp.Diff(fromCsv, fromJsonl); diff != "" {
+		t.Errorf("csv and json lines definitions differ (-csv +jsonl):\n%s", diff)
+	}
+	if lines := strings.Count(readFile(t, jsonlPath), "\n"); lines != len(fromCsv) {
+		t.Errorf("expected %d json lines, got %d", len(fromCsv), lines)
+	}
+
+	goPath := filepath.Join(dir, "dump.go")
+	options.GenPkgName = "main"
+	generate(fromJsonl, goPath)
+	fromGo, err := readGoDump(goPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(fromJson, fromGo); diff != "" {
+		t.Errorf("json and go definitions differ (-json +go):\n%s", diff)
+	}
+
+	csvA, csvB := filepath.Join(dir, "a.csv"), filepath.Join(dir, "b.csv")
+	dumpCsv(fromCsv, csvA)
+	dumpCsv(fromGo, csvB)
+	if readFile(t, csvA) != readFile(t, csvB) {
+		t.Error("csv dumps differ after csv -> json -> jsonl -> go round trip")
+	}
+}
+
+func TestReadJsonTypeNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dump.jsonl")
+	data := `{"id":1,"name":"A","numBytes":1,"type":"Unsigned","multiplier":1,"supportedModels":["FMB920"],"groups":[]}` + "\n" +
+		`{"id":2,"name":"B","numBytes":-1,"type":2,"multiplier":1,"supportedModels":["FMB920"],"groups":[]}` + "\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	res, err := readJsonDump(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[0].Type != IOElementUnsigned || res[1].Type != IOElementHEX {
+		t.Errorf("unexpected definitions: %+v", res)
+	}
+
+	if err = os.WriteFile(path, []byte(`{"id":1,"type":"Float"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = readJsonDump(path); err == nil {
+		t.Error("unknown type name accepted")
+	}
+}
+
+// saveOptions restores the global options changed by the test when it finishes
+func saveOptions(t *testing.T) {
+	saved := options
+	t.Cleanup(func() { options = saved })
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestParseParamsPage(t *testing.T) {
+	page := `<div class="mw-parser-output">
+<h2><span class="mw-headline">GPRS</span></h2>
+<table>
+<tr><th>ID</th><th>Parameter name</th><th>Value type</th><th>Min</th><th>Max</th><th>Recommended value</th><th>Comments</th></tr>
+<tr><td>2005</td><td>Port</td><td>U16</td><td>0</td><td>65535</td><td>5027</td><td>Server port</td></tr>
+<tr><td>2006</td><td>Protocol</td><td>Uint8</td><td>0</td><td>1</td><td>0</td><td>0 - TCP<br>1 - UDP</td></tr>
+<tr><td>abc</td><td>Broken</td><td>Uint8</td><td>0</td><td>1</td><td>0</td><td></td></tr>
+</table>
+<table><tr><th>Command</th><th>Description</th></tr><tr><td>getver</td><td>Version</td></tr></table>
+</div>`
+	expected := []*ParamDefinition{
+		{Id: 2005, Name: "Port", Type: 1, Max: 65535, Default: "5027", Description: "Server port",
+			SupportedModels: StringSlice{"FMB920"}, Groups: StringSlice{"GPRS"}},
+		{Id: 2006, Name: "Protocol", Type: 0, Max: 1, Default: "0", Description: "0 - TCP\n1 - UDP",
+			SupportedModels: StringSlice{"FMB920"}, Groups: StringSlice{"GPRS"}},
+	}
+	if diff := cmp.Diff(expected, parseParamsPage("FMB920", page)); diff != "" {
+		t.Errorf("unexpected parameters (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestCollectParamsHtml(t *testing.T) {
+	saveOptions(t)
+	res := collectParamsHtml("testdata/params", nil)
+	if len(res) == 0 {
+		t.Fatal("no parameters found")
+	}
+	for _, it := range res {
+		if !cmp.Equal([]string(it.SupportedModels), []string{"FMB920", "FMC650"}) {
+			t.Errorf("parameter %d: equal definitions are not merged, models %v", it.Id, it.SupportedModels)
+		}
+	}
+
+	merged := mergeParam([]*ParamDefinition{res[0]}, &ParamDefinition{Id: res[0].Id, Name: "Other",
+		SupportedModels: StringSlice{"FMB001"}, Groups: StringSlice{}})
+	if len(merged) != 2 {
+		t.Errorf("different definitions are merged")
+	}
+
+	output := filepath.Join(t.TempDir(), "params_dump.go")
+	options.GenPkgName = "main"
+	generateParams(res, output)
+	if code := readFile(t, output); !strings.Contains(code, `{2005, "Target Server Port", params.ParamUint16, 0, 65535, "0"`) {
+		t.Errorf("unexpected generated code:\n%s", code)
+	}
+}
+
+func TestGenerateIdsReservedNames(t *testing.T) {
+	saveOptions(t)
+	dir := t.TempDir()
+	pkg := "package ioelements\n\ntype DecodeMode uint8\n\nconst (\n\tLenient DecodeMode = iota\n\tStrict\n)\n\n" +
+		"func ReadDefinitions() {}\n\nfunc (r *Decoder) Decode() {}\n\ntype Decoder struct{}\n"
+	if err := os.WriteFile(filepath.Join(dir, "decoder.go"), []byte(pkg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "decoder_test.go"), []byte("package ioelements\n\nfunc Ignition() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "ids.go")
+	options.GenPkgName = "ioelements"
+	options.GenInternal = true
+	generateIds([]*IOElementDefinition{
+		{Id: 1, Name: "Strict", Type: IOElementUnsigned, NumBytes: 1, SupportedModels: []string{"FMB920"}},
+		{Id: 2, Name: "Read Definitions", Type: IOElementUnsigned, NumBytes: 1, SupportedModels: []string{"FMB920"}},
+		{Id: 3, Name: "Decoder", Type: IOElementUnsigned, NumBytes: 1, SupportedModels: []string{"FMB920"}},
+		{Id: 4, Name: "Ignition", Type: IOElementUnsigned, NumBytes: 1, SupportedModels: []string{"FMB920"}},
+	}, output)
+
+	code, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, it := range []string{"Strict_1 ", "ReadDefinitions_2 ", "Decoder_3 ", "Ignition ", "IgnitionID "} {
+		if !bytes.Contains(code, []byte("\t"+it)) {
+			t.Errorf("identifier %s is not generated:\n%s", it, code)
+		}
+	}
+	// the previous output is skipped, regenerating gives the same names
+	generateIds([]*IOElementDefinition{
+		{Id: 4, Name: "Ignition", Type: IOElementUnsigned, NumBytes: 1, SupportedModels: []string{"FMB920"}},
+	}, output)
+	if code, _ = os.ReadFile(output); !bytes.Contains(code, []byte("\tIgnitionID ")) {
+		t.Errorf("generated file is treated as reserved:\n%s", code)
+	}
+}
+
+func TestAccessorType(t *testing.T) {
+	cases := []struct {
+		def      IOElementDefinition
+		expected string
+	}{
+		{IOElementDefinition{Type: IOElementUnsigned, NumBytes: 1, Max: 1, Multiplier: 1}, "BoolElement"},
+		{IOElementDefinition{Type: IOElementUnsigned, NumBytes: 2, Max: 30000, Multiplier: 1}, "UintElement"},
+		{IOElementDefinition{Type: IOElementUnsigned, NumBytes: 2, Max: 65535, Multiplier: 0.001}, "FloatElement"},
+		{IOElementDefinition{Type: IOElementUnsigned, NumBytes: 2, Max: 30000, Multiplier: 0}, "FloatElement"},
+		{IOElementDefinition{Type: IOElementSigned, NumBytes: 2, Min: -550, Max: 1150, Multiplier: 0}, "FloatElement"},
+		{IOElementDefinition{Type: IOElementHEX, NumBytes: 8, Multiplier: 1}, "StringElement"},
+	}
+	for i, c := range cases {
+		if res := accessorType(&c.def); res != c.expected {
+			t.Errorf("case #%d: %s, expected %s", i, res, c.expected)
+		}
+	}
+}
+
+func TestGenerateIdsStableNames(t *testing.T) {
+	saveOptions(t)
+	options.GenPkgName = "ioelements"
+	options.GenInternal = true
+	def := func(id uint16, name string, models ...string) *IOElementDefinition {
+		return &IOElementDefinition{Id: id, Name: name, Type: IOElementUnsigned, NumBytes: 2, Multiplier: 1, SupportedModels: models}
+	}
+
+	output := filepath.Join(t.TempDir(), "ids.go")
+	generateIds([]*IOElementDefinition{
+		def(4, "Digital Input 4", "FMB001"),
+		def(262, "Digital Input 4", "FMB001", "FMB920"),
+		def(10, "Analog Input 2", "FMB640"),
+	}, output)
+	before, err := generatedIds(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]uint16{"DigitalInput4ID": 262, "DigitalInput4_4ID": 4, "AnalogInput2ID": 10}
+	if diff := cmp.Diff(expected, before); diff != "" {
+		t.Fatalf("unexpected names (-expected +actual):\n%s", diff)
+	}
+
+	// reordered catalogue, the other ids are now supported by more models, new ids with the same names
+	generateIds([]*IOElementDefinition{
+		def(10, "Analog Input 2", "FMB640"),
+		def(6, "Analog Input 2", "FMB001", "FMB920", "FMB640"),
+		def(262, "Digital Input 4", "FMB001", "FMB920"),
+		def(4, "Digital Input 4", "FMB001", "FMB920", "FMB640"),
+		def(3, "Digital Input 4", "FMB001"),
+	}, output)
+	after, err := generatedIds(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, id := range before {
+		if after[name] != id {
+			t.Errorf("%s changed from %d to %d", name, id, after[name])
+		}
+	}
+	if after["AnalogInput2_6ID"] != 6 || after["DigitalInput4_3ID"] != 3 {
+		t.Errorf("unexpected names of new ids %v", after)
+	}
+}
+
+func TestGenerateIdsCommittedNames(t *testing.T) {
+	saveOptions(t)
+	options.GenPkgName = "ioelements"
+	options.GenInternal = true
+	dir := t.TempDir()
+	files, err := filepath.Glob("../ioelements/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, it := range files {
+		if strings.HasSuffix(it, "_test.go") {
+			continue
+		}
+		if err = os.WriteFile(filepath.Join(dir, filepath.Base(it)), []byte(readFile(t, it)), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	output := filepath.Join(dir, "ioelements_ids.go")
+	committed, err := generatedIds(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the catalogue order does not change the names
+	data := readCsv("io_elements_dump.csv")
+	for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+		data[i], data[j] = data[j], data[i]
+	}
+	generateIds(data, output)
+	regenerated, err := generatedIds(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(committed, regenerated); diff != "" {
+		t.Errorf("exported names changed (-committed +regenerated):\n%s", diff)
+	}
+}